@@ -0,0 +1,10 @@
+// OpenAPI specification of the service
+package api
+
+import _ "embed"
+
+//go:embed openapi.json
+var OpenAPI []byte
+
+//go:embed docs.html
+var SwaggerUI []byte
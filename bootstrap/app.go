@@ -1,18 +1,35 @@
 package bootstrap
 
 import (
+	"database/sql"
+
 	"github.com/gin-gonic/gin"
 	_ "github.com/lib/pq"
+	"github.com/zeleniy/test28/internal/auth"
+	"github.com/zeleniy/test28/internal/config"
 )
 
-func SetUpApp(ginMode string, dsn string) *gin.Engine {
+// Set up the application, the database handle is returned to be closed on shutdown
+func SetUpApp(cfg *config.Config) (*gin.Engine, *sql.DB) {
+
+	logger, err := SetUpLogger(cfg.Log)
+
+	if err != nil {
+		panic(err)
+	}
 
 	SetUpGoPlayground()
-	_, err := SetUpDb(dsn)
+	db, err := SetUpDb(cfg.DB)
+
+	if err != nil {
+		panic(err)
+	}
+
+	issuer, err := auth.NewIssuer(cfg.Auth)
 
 	if err != nil {
 		panic(err)
 	}
 
-	return SetUpGin(gin.ReleaseMode)
+	return SetUpGin(cfg.Gin.Mode, cfg.Idempotency, cfg.Subscriptions, issuer, logger), db
 }
@@ -2,10 +2,13 @@ package bootstrap
 
 import (
 	"database/sql"
+	"log/slog"
 	"sync"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
 	_ "github.com/lib/pq"
+	"github.com/zeleniy/test28/internal/config"
+	"github.com/zeleniy/test28/internal/logging"
 )
 
 var (
@@ -13,22 +16,27 @@ var (
 	once sync.Once
 )
 
-func SetUpDb(dsn string) (*sql.DB, error) {
+func SetUpDb(cfg config.DBConfig) (*sql.DB, error) {
 
 	var err error
 
 	once.Do(func() {
 
-		db, err = sql.Open("postgres", dsn)
+		db, err = sql.Open("postgres", cfg.DSN())
 		if err != nil {
 			return
 		}
 
+		db.SetMaxOpenConns(cfg.MaxOpenConns)
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+		db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+		db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
 		// if err := db.Ping(); err != nil {
 		// 	return nil, err
 		// }
 
-		boil.SetDB(db)
+		boil.SetDB(logging.NewQueryLogger(db, slog.Default()))
 	})
 
 	return db, err
@@ -1,20 +1,32 @@
 package bootstrap
 
 import (
+	"log/slog"
+
 	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/auth"
+	"github.com/zeleniy/test28/internal/config"
 	"github.com/zeleniy/test28/internal/http/middleware"
 	"github.com/zeleniy/test28/routes"
 )
 
-func SetUpGin(ginMode string) *gin.Engine {
+func SetUpGin(ginMode string, idempotencyCfg config.IdempotencyConfig, subscriptionsCfg config.SubscriptionsConfig, issuer *auth.Issuer, logger *slog.Logger) *gin.Engine {
 
 	gin.SetMode(ginMode)
 
-	gin := gin.Default()
+	gin := gin.New()
+	// Multipart import file beyond this is buffered on disk rather than in memory
+	gin.MaxMultipartMemory = 8 << 20
 
-	gin.Use(middleware.DataWrapperMiddleware())
+	gin.Use(middleware.RequestLoggerMiddleware(logger))
+	gin.Use(middleware.RecoveryMiddleware(logger))
+	// Sets the actor the idempotency keys are scoped to
+	gin.Use(middleware.AuthenticationMiddleware(issuer, logger))
+	// Stores the rendered envelope, so it goes before the wrapper
+	gin.Use(middleware.IdempotencyMiddleware(idempotencyCfg, logger))
+	gin.Use(middleware.DataWrapperMiddleware(logger))
 
-	routes.SetupRoutes(gin)
+	routes.SetupRoutes(gin, subscriptionsCfg, issuer)
 
 	return gin
 }
@@ -1,7 +1,9 @@
 package bootstrap
 
 import (
+	"reflect"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin/binding"
@@ -13,9 +15,27 @@ func SetUpGoPlayground() {
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("regex", validateRegex)
 		v.RegisterValidation("date", validateDate)
+		v.RegisterValidation("monthgtefield", validateMonthGteField)
+		v.RegisterTagNameFunc(getFieldName)
 	}
 }
 
+// Report fields by the names clients send them with
+func getFieldName(field reflect.StructField) string {
+
+	for _, tag := range []string{"json", "form", "uri"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
 // Date validator
 func validateDate(fl validator.FieldLevel) bool {
 
@@ -34,6 +54,33 @@ func validateDate(fl validator.FieldLevel) bool {
 	return err == nil
 }
 
+// Month (MM-YYYY) is not earlier than the month in the field given as parameter
+func validateMonthGteField(fl validator.FieldLevel) bool {
+
+	value, ok := fl.Field().Interface().(string)
+
+	if !ok {
+		return false
+	}
+
+	otherField, kind, _, found := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+	if !found || kind != reflect.String {
+		return false
+	}
+
+	month, err := time.Parse("01-2006", value)
+	if err != nil {
+		return false
+	}
+
+	otherMonth, err := time.Parse("01-2006", otherField.String())
+	if err != nil {
+		return false
+	}
+
+	return !month.Before(otherMonth)
+}
+
 // Regex validator
 func validateRegex(fl validator.FieldLevel) bool {
 
@@ -0,0 +1,22 @@
+package bootstrap
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/zeleniy/test28/internal/config"
+	"github.com/zeleniy/test28/internal/logging"
+)
+
+func SetUpLogger(cfg config.LogConfig) (*slog.Logger, error) {
+
+	logger, err := logging.New(os.Stdout, cfg.Format, cfg.Level)
+
+	if err != nil {
+		return nil, err
+	}
+
+	slog.SetDefault(logger)
+
+	return logger, nil
+}
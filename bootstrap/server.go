@@ -0,0 +1,70 @@
+package bootstrap
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/zeleniy/test28/internal/config"
+)
+
+func SetUpServer(cfg config.HTTPConfig, handler http.Handler) *http.Server {
+
+	return &http.Server{
+		Addr:              cfg.Address,
+		Handler:           handler,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
+}
+
+// Serve requests until the context is cancelled, then drain in-flight requests and close the database
+func RunServer(ctx context.Context, server *http.Server, cfg config.HTTPConfig, db *sql.DB) error {
+
+	logger := slog.Default()
+	serveErrors := make(chan error, 1)
+
+	go func() {
+		logger.Info("http server started", slog.String("address", server.Addr))
+		serveErrors <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErrors:
+		if !errors.Is(err, http.ErrServerClosed) {
+			db.Close()
+			return err
+		}
+	case <-ctx.Done():
+		logger.Info("shutdown signal received")
+	}
+
+	logger.Info("draining in-flight requests", slog.Duration("timeout", cfg.ShutdownTimeout))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	shutdownErr := server.Shutdown(shutdownCtx)
+
+	if shutdownErr != nil {
+		logger.Warn("drain deadline exceeded, closing remaining connections", slog.String("error", shutdownErr.Error()))
+		server.Close()
+	} else {
+		logger.Info("in-flight requests drained")
+	}
+
+	logger.Info("closing database connections")
+
+	if err := db.Close(); err != nil {
+		logger.Error("cannot close database connections", slog.String("error", err.Error()))
+		return errors.Join(shutdownErr, err)
+	}
+
+	logger.Info("shutdown complete")
+
+	return shutdownErr
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/zeleniy/test28/bootstrap"
+	"github.com/zeleniy/test28/internal/auth"
+	"github.com/zeleniy/test28/internal/config"
+	"github.com/zeleniy/test28/internal/idempotency"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// Delete soft deleted subscriptions past the retention period, expired idempotency keys and sessions:
+// go run cmd/purge/main.go [-retention 720h]
+func main() {
+
+	retention := flag.Duration("retention", 0, "retention period, SUBSCRIPTIONS_RETENTION when not given")
+	flag.Parse()
+
+	if err := run(*retention); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(retention time.Duration) error {
+
+	cfg, err := config.Load(".")
+	if err == nil {
+		err = cfg.Validate()
+	}
+	if err != nil {
+		return err
+	}
+
+	if retention <= 0 {
+		retention = cfg.Subscriptions.Retention
+	}
+
+	if _, err := bootstrap.SetUpDb(cfg.DB); err != nil {
+		return err
+	}
+
+	deletedBefore := time.Now().Add(-retention)
+
+	// Prices are deleted along with subscriptions by the foreign key cascade, audit trail is kept detached from them
+	purged, err := models.Subscriptions(
+		qm.WithDeleted(),
+		models.SubscriptionWhere.DeletedAt.LT(null.TimeFrom(deletedBefore)),
+	).DeleteAll(context.Background(), boil.GetContextDB(), true)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Purged %d subscriptions deleted before %s\n", purged, deletedBefore.Format(time.RFC3339))
+
+	purged, err = idempotency.Purge(context.Background(), boil.GetContextDB())
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Purged %d expired idempotency keys\n", purged)
+
+	purged, err = auth.Purge(context.Background(), boil.GetContextDB())
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Purged %d expired sessions\n", purged)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/zeleniy/test28/bootstrap"
+	"github.com/zeleniy/test28/internal/config"
+	"github.com/zeleniy/test28/internal/currency"
+	"github.com/zeleniy/test28/internal/database"
+)
+
+// Load exchange rates from CSV file: go run cmd/rates/main.go rates.csv
+func main() {
+
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: rates <file.csv>")
+		os.Exit(2)
+	}
+
+	if err := run(os.Args[1]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(path string) error {
+
+	cfg, err := config.Load(".")
+	if err == nil {
+		err = cfg.Validate()
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := bootstrap.SetUpDb(cfg.DB); err != nil {
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	rates, err := currency.ReadRates(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	// File is loaded entirely or not at all
+	err = database.Transaction(context.Background(), func(exec boil.ContextExecutor) error {
+		return currency.SaveRates(context.Background(), exec, rates)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Loaded %d exchange rates\n", len(rates))
+
+	return nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"os"
 	"time"
@@ -11,34 +12,97 @@ import (
 	"github.com/go-faker/faker/v4"
 	"github.com/zeleniy/test28/bootstrap"
 	"github.com/zeleniy/test28/database/seeders"
+	"github.com/zeleniy/test28/internal/config"
 	"github.com/zeleniy/test28/internal/models"
+	"golang.org/x/crypto/bcrypt"
 )
 
+// Password of every seeded user, to log in with
+const seedPassword = "password"
+
 func main() {
 
-	bootstrap.SetUpDb(os.Getenv("DB_URL"))
+	cfg, err := config.Load(".")
+	if err == nil {
+		err = cfg.Validate()
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	bootstrap.SetUpDb(cfg.DB)
+
+	serviceNames := []string{"Okko", "Yandex", "Wink", "Sber", "Ivi"}
 
 	seeder := &seeders.Seeder{}
+	seeder.MinServicesToSeed = len(serviceNames)
 	seeder.MinSubscriptionsToSeed = 15
 	seeder.MinUsersToSeed = 10
 
-	seeder.RandomUser = func() (*models.User, error) {
-		return &models.User{
-			Login:        faker.Username(),
-			PasswordHash: faker.Password(),
+	seeder.RandomService = func() (*models.Service, error) {
+		name := serviceNames[0]
+		serviceNames = serviceNames[1:]
+		return &models.Service{
+			Name: name,
 		}, nil
 	}
 
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(seedPassword), bcrypt.DefaultCost)
+
+	if err != nil {
+		panic(err)
+	}
+
+	// The first user is the admin
+	admin := true
+
+	seeder.RandomUser = func() (*models.User, error) {
+		user := &models.User{
+			Login:        null.StringFrom(faker.Username()),
+			PasswordHash: null.StringFrom(string(passwordHash)),
+			IsAdmin:      admin,
+		}
+		admin = false
+		return user, nil
+	}
+
 	seeder.RandomSubscription = func() (*models.Subscription, error) {
 		return &models.Subscription{
-			ServiceName: []string{"Okko", "Yandex", "Wink", "Sber", "Ivi"}[rand.Intn(5)],
-			Price:       []int{10, 20, 30, 40, 50}[rand.Intn(5)],
-			StartDate:   randomDateInRange(time.Now().AddDate(-1, 0, 0), time.Now()),            // any time in in 1 year before now
-			EndDate:     null.TimeFrom(time.Now().AddDate(0, []int{1, 6, 12}[rand.Intn(3)], 0)), // 1, 6 or 12 months duration
+			StartDate: randomDateInRange(time.Now().AddDate(-1, 0, 0), time.Now()),            // any time in in 1 year before now
+			EndDate:   null.TimeFrom(time.Now().AddDate(0, []int{1, 6, 12}[rand.Intn(3)], 0)), // 1, 6 or 12 months duration
 		}, nil
 	}
 
-	err := seeder.Run(context.Background(), boil.GetContextDB())
+	// Every subscription gets its own user and service pair, periods of the same pair can not overlap
+	seeder.SubscriptionForeignKeySetter = func(i int, o *models.Subscription, allServices models.ServiceSlice, allUsers models.UserSlice) error {
+		o.ServiceID = allServices[i%len(allServices)].ID
+		o.UserID = allUsers[i/len(allServices)%len(allUsers)].UUID
+		return nil
+	}
+
+	seeder.SubscriptionPricesPerSubscription = 1
+
+	seeder.RandomSubscriptionPrice = func() (*models.SubscriptionPrice, error) {
+		return []*models.SubscriptionPrice{
+			{Price: 19900, Currency: "RUB"},
+			{Price: 29900, Currency: "RUB"},
+			{Price: 39900, Currency: "RUB"},
+			{Price: 999, Currency: "USD"},
+			{Price: 1299, Currency: "EUR"},
+		}[rand.Intn(5)], nil
+	}
+
+	// Initial price is effective from the subscription start
+	seeder.SubscriptionPriceForeignKeySetter = func(i int, o *models.SubscriptionPrice, allSubscriptions models.SubscriptionSlice) error {
+		subscription := allSubscriptions[i%len(allSubscriptions)]
+		o.SubscriptionID = subscription.ID
+		o.EffectiveFrom = subscription.StartDate
+		return nil
+	}
+
+	err = seeder.Run(context.Background(), boil.GetContextDB())
 
 	if err != nil {
 		panic(err)
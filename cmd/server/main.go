@@ -1,14 +1,35 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
-	"github.com/gin-gonic/gin"
 	"github.com/zeleniy/test28/bootstrap"
+	"github.com/zeleniy/test28/internal/config"
 )
 
 func main() {
 
-	bootstrap.SetUpApp(gin.ReleaseMode, os.Getenv("DB_URL")).
-		Run(":8080")
+	cfg, err := config.Load(".")
+	if err == nil {
+		err = cfg.Validate()
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	engine, db := bootstrap.SetUpApp(cfg)
+
+	if err := bootstrap.RunServer(ctx, bootstrap.SetUpServer(cfg.HTTP, engine), cfg.HTTP, db); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
@@ -10,12 +10,50 @@ import (
 )
 
 type Factory struct {
-	baseSubscriptionMod SubscriptionMod
-	baseUserMod         UserMod
+	baseAuthSessionMod       AuthSessionMod
+	baseExchangeRateMod      ExchangeRateMod
+	baseIdempotencyKeyMod    IdempotencyKeyMod
+	baseServiceMod           ServiceMod
+	baseSubscriptionMod      SubscriptionMod
+	baseSubscriptionAuditMod SubscriptionAuditMod
+	baseSubscriptionPriceMod SubscriptionPriceMod
+	baseUserMod              UserMod
 }
 
 var defaultFactory = new(Factory)
 
+func SetBaseAuthSessionMod(mod AuthSessionMod) {
+	defaultFactory.SetBaseAuthSessionMod(mod)
+}
+
+func (f *Factory) SetBaseAuthSessionMod(mod AuthSessionMod) {
+	f.baseAuthSessionMod = mod
+}
+
+func SetBaseExchangeRateMod(mod ExchangeRateMod) {
+	defaultFactory.SetBaseExchangeRateMod(mod)
+}
+
+func (f *Factory) SetBaseExchangeRateMod(mod ExchangeRateMod) {
+	f.baseExchangeRateMod = mod
+}
+
+func SetBaseIdempotencyKeyMod(mod IdempotencyKeyMod) {
+	defaultFactory.SetBaseIdempotencyKeyMod(mod)
+}
+
+func (f *Factory) SetBaseIdempotencyKeyMod(mod IdempotencyKeyMod) {
+	f.baseIdempotencyKeyMod = mod
+}
+
+func SetBaseServiceMod(mod ServiceMod) {
+	defaultFactory.SetBaseServiceMod(mod)
+}
+
+func (f *Factory) SetBaseServiceMod(mod ServiceMod) {
+	f.baseServiceMod = mod
+}
+
 func SetBaseSubscriptionMod(mod SubscriptionMod) {
 	defaultFactory.SetBaseSubscriptionMod(mod)
 }
@@ -24,6 +62,22 @@ func (f *Factory) SetBaseSubscriptionMod(mod SubscriptionMod) {
 	f.baseSubscriptionMod = mod
 }
 
+func SetBaseSubscriptionAuditMod(mod SubscriptionAuditMod) {
+	defaultFactory.SetBaseSubscriptionAuditMod(mod)
+}
+
+func (f *Factory) SetBaseSubscriptionAuditMod(mod SubscriptionAuditMod) {
+	f.baseSubscriptionAuditMod = mod
+}
+
+func SetBaseSubscriptionPriceMod(mod SubscriptionPriceMod) {
+	defaultFactory.SetBaseSubscriptionPriceMod(mod)
+}
+
+func (f *Factory) SetBaseSubscriptionPriceMod(mod SubscriptionPriceMod) {
+	f.baseSubscriptionPriceMod = mod
+}
+
 func SetBaseUserMod(mod UserMod) {
 	defaultFactory.SetBaseUserMod(mod)
 }
@@ -119,6 +119,18 @@ func (f Factory) InsertSubscription(ctx context.Context, exec boil.ContextExecut
 		}
 	}
 
+	if isZero(o.ServiceID) {
+		related, err := f.CreateAndInsertService(ctx, exec)
+		if err != nil {
+			return err
+		}
+
+		err = SubscriptionWithService(related).Apply(o)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = o.Insert(ctx, exec, boil.Infer())
 	if err != nil {
 		return err
@@ -127,6 +139,31 @@ func (f Factory) InsertSubscription(ctx context.Context, exec boil.ContextExecut
 	// Save in context to ensure we don't enter an infinite loop when adding relationships
 	ctx = addToContextKey(ctx, key, val)
 
+	if len(o.R.SubscriptionAudits) > 0 {
+		for _, related := range o.R.SubscriptionAudits {
+			// After inserting, the ID of our current model may have been updated
+			// we should updated it in the relations before inserting
+			queries.Assign(&related.SubscriptionID, o.ID)
+			related.SubscriptionUUID = o.UUID
+			err = f.InsertSubscriptionAudit(ctx, exec, related)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(o.R.SubscriptionPrices) > 0 {
+		for _, related := range o.R.SubscriptionPrices {
+			// After inserting, the ID of our current model may have been updated
+			// we should updated it in the relations before inserting
+			related.SubscriptionID = o.ID
+			err = f.InsertSubscriptionPrice(ctx, exec, related)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -193,14 +230,14 @@ func SubscriptionIDFunc(f func() (int, error)) SubscriptionMod {
 	})
 }
 
-func SubscriptionUserID(val int) SubscriptionMod {
+func SubscriptionUserID(val string) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
 		o.UserID = val
 		return nil
 	})
 }
 
-func SubscriptionUserIDFunc(f func() (int, error)) SubscriptionMod {
+func SubscriptionUserIDFunc(f func() (string, error)) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
 		var err error
 		o.UserID, err = f()
@@ -208,77 +245,107 @@ func SubscriptionUserIDFunc(f func() (int, error)) SubscriptionMod {
 	})
 }
 
-func SubscriptionServiceName(val string) SubscriptionMod {
+func SubscriptionStartDate(val time.Time) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
-		o.ServiceName = val
+		o.StartDate = val
 		return nil
 	})
 }
 
-func SubscriptionServiceNameFunc(f func() (string, error)) SubscriptionMod {
+func SubscriptionStartDateFunc(f func() (time.Time, error)) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
 		var err error
-		o.ServiceName, err = f()
+		o.StartDate, err = f()
 		return err
 	})
 }
 
-func SubscriptionPrice(val int) SubscriptionMod {
+func SubscriptionEndDate(val null.Time) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
-		o.Price = val
+		o.EndDate = val
 		return nil
 	})
 }
 
-func SubscriptionPriceFunc(f func() (int, error)) SubscriptionMod {
+func SubscriptionEndDateFunc(f func() (null.Time, error)) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
 		var err error
-		o.Price, err = f()
+		o.EndDate, err = f()
 		return err
 	})
 }
 
-func SubscriptionStartDate(val time.Time) SubscriptionMod {
+func SubscriptionCreatedAt(val time.Time) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
-		o.StartDate = val
+		o.CreatedAt = val
 		return nil
 	})
 }
 
-func SubscriptionStartDateFunc(f func() (time.Time, error)) SubscriptionMod {
+func SubscriptionCreatedAtFunc(f func() (time.Time, error)) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
 		var err error
-		o.StartDate, err = f()
+		o.CreatedAt, err = f()
 		return err
 	})
 }
 
-func SubscriptionEndDate(val null.Time) SubscriptionMod {
+func SubscriptionServiceID(val int) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
-		o.EndDate = val
+		o.ServiceID = val
 		return nil
 	})
 }
 
-func SubscriptionEndDateFunc(f func() (null.Time, error)) SubscriptionMod {
+func SubscriptionServiceIDFunc(f func() (int, error)) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
 		var err error
-		o.EndDate, err = f()
+		o.ServiceID, err = f()
 		return err
 	})
 }
 
-func SubscriptionCreatedAt(val time.Time) SubscriptionMod {
+func SubscriptionDeletedAt(val null.Time) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
-		o.CreatedAt = val
+		o.DeletedAt = val
 		return nil
 	})
 }
 
-func SubscriptionCreatedAtFunc(f func() (time.Time, error)) SubscriptionMod {
+func SubscriptionDeletedAtFunc(f func() (null.Time, error)) SubscriptionMod {
 	return SubscriptionModFunc(func(o *models.Subscription) error {
 		var err error
-		o.CreatedAt, err = f()
+		o.DeletedAt, err = f()
+		return err
+	})
+}
+
+func SubscriptionVersion(val int) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		o.Version = val
+		return nil
+	})
+}
+
+func SubscriptionVersionFunc(f func() (int, error)) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		var err error
+		o.Version, err = f()
+		return err
+	})
+}
+
+func SubscriptionUUID(val string) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		o.UUID = val
+		return nil
+	})
+}
+
+func SubscriptionUUIDFunc(f func() (string, error)) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		var err error
+		o.UUID, err = f()
 		return err
 	})
 }
@@ -289,7 +356,7 @@ func SubscriptionWithUser(related *models.User) SubscriptionMod {
 			o.R = o.R.NewStruct()
 		}
 
-		o.UserID = related.ID
+		o.UserID = related.UUID
 		o.R.User = related
 
 		if related.R == nil {
@@ -326,3 +393,237 @@ func SubscriptionWithNewUser(f *Factory, mods ...UserMod) SubscriptionMod {
 		return SubscriptionWithUser(related).Apply(o)
 	})
 }
+
+func SubscriptionWithService(related *models.Service) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		if o.R == nil {
+			o.R = o.R.NewStruct()
+		}
+
+		o.ServiceID = related.ID
+		o.R.Service = related
+
+		if related.R == nil {
+			related.R = related.R.NewStruct()
+		}
+
+		related.R.Subscriptions = append(related.R.Subscriptions, o)
+		return nil
+	})
+}
+
+func SubscriptionWithServiceFunc(f func() (*models.Service, error)) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		related, err := f()
+		if err != nil {
+			return err
+		}
+
+		return SubscriptionWithService(related).Apply(o)
+	})
+}
+
+func SubscriptionWithNewService(f *Factory, mods ...ServiceMod) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		if f == nil {
+			f = defaultFactory
+		}
+
+		related, err := f.CreateService(mods...)
+		if err != nil {
+			return err
+		}
+
+		return SubscriptionWithService(related).Apply(o)
+	})
+}
+
+func SubscriptionWithSubscriptionAudits(related models.SubscriptionAuditSlice) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		if o.R == nil {
+			o.R = o.R.NewStruct()
+		}
+
+		o.R.SubscriptionAudits = related
+
+		for _, rel := range related {
+			if rel.R == nil {
+				rel.R = rel.R.NewStruct()
+			}
+
+			queries.Assign(&rel.SubscriptionID, o.ID)
+			rel.SubscriptionUUID = o.UUID
+			rel.R.Subscription = o
+		}
+
+		return nil
+	})
+}
+
+func SubscriptionWithSubscriptionAuditsFunc(f func() (models.SubscriptionAuditSlice, error)) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		related, err := f()
+		if err != nil {
+			return err
+		}
+
+		return SubscriptionWithSubscriptionAudits(related).Apply(o)
+	})
+}
+
+func SubscriptionWithNewSubscriptionAudits(f *Factory, number int, mods ...SubscriptionAuditMod) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		if f == nil {
+			f = defaultFactory
+		}
+
+		related, err := f.CreateSubscriptionAudits(number, mods...)
+		if err != nil {
+			return err
+		}
+
+		return SubscriptionWithSubscriptionAudits(related).Apply(o)
+	})
+}
+
+func SubscriptionAddSubscriptionAudits(related models.SubscriptionAuditSlice) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		if o.R == nil {
+			o.R = o.R.NewStruct()
+		}
+
+		o.R.SubscriptionAudits = append(o.R.SubscriptionAudits, related...)
+
+		for _, rel := range related {
+			if rel.R == nil {
+				rel.R = rel.R.NewStruct()
+			}
+
+			queries.Assign(&rel.SubscriptionID, o.ID)
+			rel.SubscriptionUUID = o.UUID
+			rel.R.Subscription = o
+		}
+
+		return nil
+	})
+}
+
+func SubscriptionAddSubscriptionAuditsFunc(f func() (models.SubscriptionAuditSlice, error)) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		related, err := f()
+		if err != nil {
+			return err
+		}
+
+		return SubscriptionAddSubscriptionAudits(related).Apply(o)
+	})
+}
+
+func SubscriptionAddNewSubscriptionAudits(f *Factory, number int, mods ...SubscriptionAuditMod) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		if f == nil {
+			f = defaultFactory
+		}
+
+		related, err := f.CreateSubscriptionAudits(number, mods...)
+		if err != nil {
+			return err
+		}
+
+		return SubscriptionAddSubscriptionAudits(related).Apply(o)
+	})
+}
+
+func SubscriptionWithSubscriptionPrices(related models.SubscriptionPriceSlice) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		if o.R == nil {
+			o.R = o.R.NewStruct()
+		}
+
+		o.R.SubscriptionPrices = related
+
+		for _, rel := range related {
+			if rel.R == nil {
+				rel.R = rel.R.NewStruct()
+			}
+
+			rel.SubscriptionID = o.ID
+			rel.R.Subscription = o
+		}
+
+		return nil
+	})
+}
+
+func SubscriptionWithSubscriptionPricesFunc(f func() (models.SubscriptionPriceSlice, error)) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		related, err := f()
+		if err != nil {
+			return err
+		}
+
+		return SubscriptionWithSubscriptionPrices(related).Apply(o)
+	})
+}
+
+func SubscriptionWithNewSubscriptionPrices(f *Factory, number int, mods ...SubscriptionPriceMod) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		if f == nil {
+			f = defaultFactory
+		}
+
+		related, err := f.CreateSubscriptionPrices(number, mods...)
+		if err != nil {
+			return err
+		}
+
+		return SubscriptionWithSubscriptionPrices(related).Apply(o)
+	})
+}
+
+func SubscriptionAddSubscriptionPrices(related models.SubscriptionPriceSlice) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		if o.R == nil {
+			o.R = o.R.NewStruct()
+		}
+
+		o.R.SubscriptionPrices = append(o.R.SubscriptionPrices, related...)
+
+		for _, rel := range related {
+			if rel.R == nil {
+				rel.R = rel.R.NewStruct()
+			}
+
+			rel.SubscriptionID = o.ID
+			rel.R.Subscription = o
+		}
+
+		return nil
+	})
+}
+
+func SubscriptionAddSubscriptionPricesFunc(f func() (models.SubscriptionPriceSlice, error)) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		related, err := f()
+		if err != nil {
+			return err
+		}
+
+		return SubscriptionAddSubscriptionPrices(related).Apply(o)
+	})
+}
+
+func SubscriptionAddNewSubscriptionPrices(f *Factory, number int, mods ...SubscriptionPriceMod) SubscriptionMod {
+	return SubscriptionModFunc(func(o *models.Subscription) error {
+		if f == nil {
+			f = defaultFactory
+		}
+
+		related, err := f.CreateSubscriptionPrices(number, mods...)
+		if err != nil {
+			return err
+		}
+
+		return SubscriptionAddSubscriptionPrices(related).Apply(o)
+	})
+}
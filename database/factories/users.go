@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	models "github.com/zeleniy/test28/internal/models"
@@ -114,11 +115,23 @@ func (f Factory) InsertUser(ctx context.Context, exec boil.ContextExecutor, o *m
 	// Save in context to ensure we don't enter an infinite loop when adding relationships
 	ctx = addToContextKey(ctx, key, val)
 
+	if len(o.R.AuthSessions) > 0 {
+		for _, related := range o.R.AuthSessions {
+			// After inserting, the ID of our current model may have been updated
+			// we should updated it in the relations before inserting
+			related.UserID = o.UUID
+			err = f.InsertAuthSession(ctx, exec, related)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(o.R.Subscriptions) > 0 {
 		for _, related := range o.R.Subscriptions {
 			// After inserting, the ID of our current model may have been updated
 			// we should updated it in the relations before inserting
-			related.UserID = o.ID
+			related.UserID = o.UUID
 			err = f.InsertSubscription(ctx, exec, related)
 			if err != nil {
 				return err
@@ -192,14 +205,14 @@ func UserIDFunc(f func() (int, error)) UserMod {
 	})
 }
 
-func UserLogin(val string) UserMod {
+func UserLogin(val null.String) UserMod {
 	return UserModFunc(func(o *models.User) error {
 		o.Login = val
 		return nil
 	})
 }
 
-func UserLoginFunc(f func() (string, error)) UserMod {
+func UserLoginFunc(f func() (null.String, error)) UserMod {
 	return UserModFunc(func(o *models.User) error {
 		var err error
 		o.Login, err = f()
@@ -207,14 +220,14 @@ func UserLoginFunc(f func() (string, error)) UserMod {
 	})
 }
 
-func UserPasswordHash(val string) UserMod {
+func UserPasswordHash(val null.String) UserMod {
 	return UserModFunc(func(o *models.User) error {
 		o.PasswordHash = val
 		return nil
 	})
 }
 
-func UserPasswordHashFunc(f func() (string, error)) UserMod {
+func UserPasswordHashFunc(f func() (null.String, error)) UserMod {
 	return UserModFunc(func(o *models.User) error {
 		var err error
 		o.PasswordHash, err = f()
@@ -252,6 +265,115 @@ func UserUUIDFunc(f func() (string, error)) UserMod {
 	})
 }
 
+func UserIsAdmin(val bool) UserMod {
+	return UserModFunc(func(o *models.User) error {
+		o.IsAdmin = val
+		return nil
+	})
+}
+
+func UserIsAdminFunc(f func() (bool, error)) UserMod {
+	return UserModFunc(func(o *models.User) error {
+		var err error
+		o.IsAdmin, err = f()
+		return err
+	})
+}
+
+func UserWithAuthSessions(related models.AuthSessionSlice) UserMod {
+	return UserModFunc(func(o *models.User) error {
+		if o.R == nil {
+			o.R = o.R.NewStruct()
+		}
+
+		o.R.AuthSessions = related
+
+		for _, rel := range related {
+			if rel.R == nil {
+				rel.R = rel.R.NewStruct()
+			}
+
+			rel.UserID = o.UUID
+			rel.R.User = o
+		}
+
+		return nil
+	})
+}
+
+func UserWithAuthSessionsFunc(f func() (models.AuthSessionSlice, error)) UserMod {
+	return UserModFunc(func(o *models.User) error {
+		related, err := f()
+		if err != nil {
+			return err
+		}
+
+		return UserWithAuthSessions(related).Apply(o)
+	})
+}
+
+func UserWithNewAuthSessions(f *Factory, number int, mods ...AuthSessionMod) UserMod {
+	return UserModFunc(func(o *models.User) error {
+		if f == nil {
+			f = defaultFactory
+		}
+
+		related, err := f.CreateAuthSessions(number, mods...)
+		if err != nil {
+			return err
+		}
+
+		return UserWithAuthSessions(related).Apply(o)
+	})
+}
+
+func UserAddAuthSessions(related models.AuthSessionSlice) UserMod {
+	return UserModFunc(func(o *models.User) error {
+		if o.R == nil {
+			o.R = o.R.NewStruct()
+		}
+
+		o.R.AuthSessions = append(o.R.AuthSessions, related...)
+
+		for _, rel := range related {
+			if rel.R == nil {
+				rel.R = rel.R.NewStruct()
+			}
+
+			rel.UserID = o.UUID
+			rel.R.User = o
+		}
+
+		return nil
+	})
+}
+
+func UserAddAuthSessionsFunc(f func() (models.AuthSessionSlice, error)) UserMod {
+	return UserModFunc(func(o *models.User) error {
+		related, err := f()
+		if err != nil {
+			return err
+		}
+
+		return UserAddAuthSessions(related).Apply(o)
+	})
+}
+
+func UserAddNewAuthSessions(f *Factory, number int, mods ...AuthSessionMod) UserMod {
+	return UserModFunc(func(o *models.User) error {
+		if f == nil {
+			f = defaultFactory
+		}
+
+		related, err := f.CreateAuthSessions(number, mods...)
+		if err != nil {
+			return err
+		}
+
+		return UserAddAuthSessions(related).Apply(o)
+	})
+}
+
 func UserWithSubscriptions(related models.SubscriptionSlice) UserMod {
 	return UserModFunc(func(o *models.User) error {
 		if o.R == nil {
@@ -265,7 +387,7 @@ func UserWithSubscriptions(related models.SubscriptionSlice) UserMod {
 				rel.R = rel.R.NewStruct()
 			}
 
-			rel.UserID = o.ID
+			rel.UserID = o.UUID
 			rel.R.User = o
 		}
 
@@ -312,7 +434,7 @@ func UserAddSubscriptions(related models.SubscriptionSlice) UserMod {
 				rel.R = rel.R.NewStruct()
 			}
 
-			rel.UserID = o.ID
+			rel.UserID = o.UUID
 			rel.R.User = o
 		}
 
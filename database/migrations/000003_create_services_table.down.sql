@@ -0,0 +1,13 @@
+ALTER TABLE subscriptions ADD COLUMN service_name VARCHAR(255);
+
+UPDATE subscriptions
+SET service_name = services.name
+FROM services
+WHERE services.id = subscriptions.service_id;
+
+ALTER TABLE subscriptions ALTER COLUMN service_name SET NOT NULL;
+ALTER TABLE subscriptions DROP COLUMN service_id;
+
+COMMENT ON COLUMN subscriptions.service_name IS 'Subscribed service';
+
+DROP TABLE IF EXISTS services;
@@ -0,0 +1,32 @@
+CREATE TABLE services (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX services_name_key ON services (LOWER(name));
+
+COMMENT ON TABLE services IS 'Subscribable services catalog';
+COMMENT ON COLUMN services.id IS 'Primary key';
+COMMENT ON COLUMN services.name IS 'Service name, unique case insensitively';
+COMMENT ON COLUMN services.created_at IS 'Date created';
+
+-- Spellings differing only by case and surrounding spaces are merged into the earliest created one
+INSERT INTO services (name, created_at)
+SELECT DISTINCT ON (LOWER(TRIM(service_name))) TRIM(service_name), created_at
+FROM subscriptions
+ORDER BY LOWER(TRIM(service_name)), created_at, id;
+
+ALTER TABLE subscriptions ADD COLUMN service_id INTEGER REFERENCES services(id) ON DELETE RESTRICT;
+
+UPDATE subscriptions
+SET service_id = services.id
+FROM services
+WHERE LOWER(services.name) = LOWER(TRIM(subscriptions.service_name));
+
+ALTER TABLE subscriptions ALTER COLUMN service_id SET NOT NULL;
+ALTER TABLE subscriptions DROP COLUMN service_name;
+
+CREATE INDEX subscriptions_service_id_idx ON subscriptions (service_id);
+
+COMMENT ON COLUMN subscriptions.service_id IS 'Reference to services.id';
@@ -0,0 +1,19 @@
+ALTER TABLE subscriptions ADD COLUMN price INTEGER CHECK (price >= 0);
+
+-- The latest price in effect, or the earliest scheduled one for subscriptions which are not started yet
+UPDATE subscriptions
+SET price = (
+    SELECT subscription_prices.price
+    FROM subscription_prices
+    WHERE subscription_prices.subscription_id = subscriptions.id
+    ORDER BY subscription_prices.effective_from <= NOW() DESC,
+        CASE WHEN subscription_prices.effective_from <= NOW() THEN subscription_prices.effective_from END DESC,
+        subscription_prices.effective_from
+    LIMIT 1
+);
+
+ALTER TABLE subscriptions ALTER COLUMN price SET NOT NULL;
+
+COMMENT ON COLUMN subscriptions.price IS 'Subscription price';
+
+DROP TABLE IF EXISTS subscription_prices;
@@ -0,0 +1,21 @@
+CREATE TABLE subscription_prices (
+    id SERIAL PRIMARY KEY,
+    subscription_id INTEGER NOT NULL REFERENCES subscriptions(id) ON DELETE CASCADE,
+    price INTEGER NOT NULL CHECK (price >= 0),
+    effective_from TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (subscription_id, effective_from)
+);
+
+COMMENT ON TABLE subscription_prices IS 'Subscription price history including scheduled changes';
+COMMENT ON COLUMN subscription_prices.id IS 'Primary key';
+COMMENT ON COLUMN subscription_prices.subscription_id IS 'Reference to subscriptions.id';
+COMMENT ON COLUMN subscription_prices.price IS 'Monthly price';
+COMMENT ON COLUMN subscription_prices.effective_from IS 'First month the price is charged in';
+COMMENT ON COLUMN subscription_prices.created_at IS 'Date created';
+
+INSERT INTO subscription_prices (subscription_id, price, effective_from, created_at)
+SELECT id, price, start_date, created_at
+FROM subscriptions;
+
+ALTER TABLE subscriptions DROP COLUMN price;
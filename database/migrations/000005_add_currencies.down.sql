@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS exchange_rates;
+
+-- Prices go back to whole units, prices in other currencies lose their currency code
+ALTER TABLE subscription_prices DROP COLUMN currency;
+ALTER TABLE subscription_prices ALTER COLUMN price TYPE INTEGER USING ROUND(price / 100.0)::INTEGER;
+
+COMMENT ON COLUMN subscription_prices.price IS 'Monthly price';
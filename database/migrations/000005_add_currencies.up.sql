@@ -0,0 +1,24 @@
+ALTER TABLE subscription_prices ALTER COLUMN price TYPE BIGINT USING price::BIGINT * 100;
+ALTER TABLE subscription_prices ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB' CHECK (currency ~ '^[A-Z]{3}$');
+
+COMMENT ON COLUMN subscription_prices.price IS 'Monthly price in minor units of the currency';
+COMMENT ON COLUMN subscription_prices.currency IS 'ISO 4217 currency code';
+
+CREATE TABLE exchange_rates (
+    id SERIAL PRIMARY KEY,
+    base CHAR(3) NOT NULL CHECK (base ~ '^[A-Z]{3}$'),
+    quote CHAR(3) NOT NULL CHECK (quote ~ '^[A-Z]{3}$'),
+    month TIMESTAMPTZ NOT NULL,
+    -- Binary floating point can not represent most decimal rates exactly
+    rate NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (base, quote, month)
+);
+
+COMMENT ON TABLE exchange_rates IS 'Monthly currency exchange rates';
+COMMENT ON COLUMN exchange_rates.id IS 'Primary key';
+COMMENT ON COLUMN exchange_rates.base IS 'ISO 4217 code of the converted currency';
+COMMENT ON COLUMN exchange_rates.quote IS 'ISO 4217 code of the target currency';
+COMMENT ON COLUMN exchange_rates.month IS 'Month the rate applies to';
+COMMENT ON COLUMN exchange_rates.rate IS 'Units of the quote currency per unit of the base currency';
+COMMENT ON COLUMN exchange_rates.created_at IS 'Date created';
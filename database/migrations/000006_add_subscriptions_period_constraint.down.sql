@@ -0,0 +1 @@
+ALTER TABLE subscriptions DROP CONSTRAINT IF EXISTS subscriptions_period_excl;
@@ -0,0 +1,25 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Overlapping subscriptions have to be resolved manually, migration does not guess which one to keep
+DO $$
+DECLARE
+    overlaps INTEGER;
+BEGIN
+    SELECT COUNT(*) INTO overlaps
+    FROM subscriptions a
+    JOIN subscriptions b ON a.user_id = b.user_id AND a.service_id = b.service_id AND a.id < b.id
+    WHERE tsrange(date_trunc('month', a.start_date AT TIME ZONE 'UTC'), date_trunc('month', a.end_date AT TIME ZONE 'UTC'), '[]')
+        && tsrange(date_trunc('month', b.start_date AT TIME ZONE 'UTC'), date_trunc('month', b.end_date AT TIME ZONE 'UTC'), '[]');
+
+    IF overlaps > 0 THEN
+        RAISE EXCEPTION 'found % pairs of overlapping subscriptions of the same user and service', overlaps
+            USING HINT = 'End or delete the duplicated subscriptions and run the migration again';
+    END IF;
+END $$;
+
+-- Subscription is active from the start month to the end month inclusive, without end date it is ongoing
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_period_excl EXCLUDE USING gist (
+    user_id WITH =,
+    service_id WITH =,
+    tsrange(date_trunc('month', start_date AT TIME ZONE 'UTC'), date_trunc('month', end_date AT TIME ZONE 'UTC'), '[]') WITH &&
+);
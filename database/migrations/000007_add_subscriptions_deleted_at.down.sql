@@ -0,0 +1,14 @@
+-- Soft deleted subscriptions can not be told apart without the column and may overlap the live ones
+DELETE FROM subscriptions WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE subscriptions DROP CONSTRAINT subscriptions_period_excl;
+
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_period_excl EXCLUDE USING gist (
+    user_id WITH =,
+    service_id WITH =,
+    tsrange(date_trunc('month', start_date AT TIME ZONE 'UTC'), date_trunc('month', end_date AT TIME ZONE 'UTC'), '[]') WITH &&
+);
+
+DROP INDEX subscriptions_deleted_at_idx;
+
+ALTER TABLE subscriptions DROP COLUMN deleted_at;
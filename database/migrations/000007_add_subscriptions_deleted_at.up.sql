@@ -0,0 +1,15 @@
+ALTER TABLE subscriptions ADD COLUMN deleted_at TIMESTAMPTZ NULL;
+
+COMMENT ON COLUMN subscriptions.deleted_at IS 'Date soft deleted';
+
+-- Purge looks up the deleted subscriptions only
+CREATE INDEX subscriptions_deleted_at_idx ON subscriptions (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Deleted subscriptions do not take the period of the user subscription to the service
+ALTER TABLE subscriptions DROP CONSTRAINT subscriptions_period_excl;
+
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_period_excl EXCLUDE USING gist (
+    user_id WITH =,
+    service_id WITH =,
+    tsrange(date_trunc('month', start_date AT TIME ZONE 'UTC'), date_trunc('month', end_date AT TIME ZONE 'UTC'), '[]') WITH &&
+) WHERE (deleted_at IS NULL);
@@ -0,0 +1,21 @@
+-- Audit trail outlives the subscription, the purge only detaches it
+CREATE TABLE subscription_audits (
+    id SERIAL PRIMARY KEY,
+    subscription_id INTEGER NULL REFERENCES subscriptions(id) ON DELETE SET NULL,
+    operation VARCHAR(16) NOT NULL CHECK (operation IN ('insert', 'update', 'delete', 'restore')),
+    actor VARCHAR(255) NULL,
+    request_id VARCHAR(64) NULL,
+    changes JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX subscription_audits_subscription_id_idx ON subscription_audits (subscription_id, created_at, id);
+
+COMMENT ON TABLE subscription_audits IS 'Subscription changes trail';
+COMMENT ON COLUMN subscription_audits.id IS 'Primary key';
+COMMENT ON COLUMN subscription_audits.subscription_id IS 'Reference to subscriptions.id, empty once the subscription is purged';
+COMMENT ON COLUMN subscription_audits.operation IS 'Change kind: insert, update, delete or restore';
+COMMENT ON COLUMN subscription_audits.actor IS 'Who made the change';
+COMMENT ON COLUMN subscription_audits.request_id IS 'ID of the request made the change';
+COMMENT ON COLUMN subscription_audits.changes IS 'Changed fields with values before and after the change';
+COMMENT ON COLUMN subscription_audits.created_at IS 'Date of the change';
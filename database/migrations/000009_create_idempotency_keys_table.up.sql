@@ -0,0 +1,24 @@
+-- Keys are chosen by the clients, so each actor has its own key space. Anonymous requests share the empty actor
+CREATE TABLE idempotency_keys (
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    key VARCHAR(255) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    status INTEGER NULL,
+    response_headers JSONB NULL,
+    response_body BYTEA NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (actor, key)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+COMMENT ON TABLE idempotency_keys IS 'Responses stored for the Idempotency-Key request header';
+COMMENT ON COLUMN idempotency_keys.actor IS 'Who sent the request, empty for anonymous requests';
+COMMENT ON COLUMN idempotency_keys.key IS 'Idempotency-Key header value';
+COMMENT ON COLUMN idempotency_keys.request_hash IS 'SHA-256 of the request method, URI and body';
+COMMENT ON COLUMN idempotency_keys.status IS 'Response status, NULL while the request is in progress';
+COMMENT ON COLUMN idempotency_keys.response_headers IS 'Response headers';
+COMMENT ON COLUMN idempotency_keys.response_body IS 'Response body';
+COMMENT ON COLUMN idempotency_keys.created_at IS 'Date of the first request';
+COMMENT ON COLUMN idempotency_keys.expires_at IS 'Date after which the key can be reused';
@@ -0,0 +1,3 @@
+ALTER TABLE subscriptions ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN subscriptions.version IS 'Incremented on every change, exposed as ETag';
@@ -0,0 +1,3 @@
+ALTER TABLE subscription_audits DROP COLUMN subscription_uuid;
+
+ALTER TABLE subscriptions DROP COLUMN uuid;
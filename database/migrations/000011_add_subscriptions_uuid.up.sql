@@ -0,0 +1,12 @@
+-- Column is added without a volatile default, so that the existing rows are not rewritten with the table lock held.
+-- They are filled by the next migration
+ALTER TABLE subscriptions ADD COLUMN uuid UUID NULL;
+
+ALTER TABLE subscriptions ALTER COLUMN uuid SET DEFAULT gen_random_uuid();
+
+COMMENT ON COLUMN subscriptions.uuid IS 'Public identifier';
+
+-- Audit trail identifies the subscription by the UUID after the purge
+ALTER TABLE subscription_audits ADD COLUMN subscription_uuid UUID NULL;
+
+COMMENT ON COLUMN subscription_audits.subscription_uuid IS 'Public identifier of the subscription, kept after the purge';
@@ -0,0 +1,7 @@
+DROP INDEX subscription_audits_subscription_uuid_idx;
+
+ALTER TABLE subscription_audits ALTER COLUMN subscription_uuid DROP NOT NULL;
+
+ALTER TABLE subscriptions DROP CONSTRAINT subscriptions_uuid_key;
+
+ALTER TABLE subscriptions ALTER COLUMN uuid DROP NOT NULL;
@@ -0,0 +1,16 @@
+UPDATE subscriptions SET uuid = gen_random_uuid() WHERE uuid IS NULL;
+
+ALTER TABLE subscriptions ALTER COLUMN uuid SET NOT NULL;
+
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_uuid_key UNIQUE (uuid);
+
+UPDATE subscription_audits SET subscription_uuid = subscriptions.uuid
+FROM subscriptions
+WHERE subscriptions.id = subscription_audits.subscription_id;
+
+-- Subscriptions purged before they got the UUID can not be told apart any more, their entries get own identifiers
+UPDATE subscription_audits SET subscription_uuid = gen_random_uuid() WHERE subscription_uuid IS NULL;
+
+ALTER TABLE subscription_audits ALTER COLUMN subscription_uuid SET NOT NULL;
+
+CREATE INDEX subscription_audits_subscription_uuid_idx ON subscription_audits (subscription_uuid, created_at, id);
@@ -0,0 +1,44 @@
+-- Provisioned users have no credentials and can not be kept, they have to be given ones or removed manually
+DO $$
+DECLARE
+    provisioned INTEGER;
+BEGIN
+    SELECT COUNT(*) INTO provisioned
+    FROM users
+    WHERE login IS NULL OR password_hash IS NULL;
+
+    IF provisioned > 0 THEN
+        RAISE EXCEPTION 'found % users without login or password, migration would delete them along with their subscriptions', provisioned
+            USING HINT = 'Set login and password_hash of these users or delete them manually';
+    END IF;
+END $$;
+
+ALTER TABLE subscriptions ADD COLUMN user_serial_id INTEGER NULL;
+
+UPDATE subscriptions SET user_serial_id = users.id FROM users WHERE users.uuid = subscriptions.user_id;
+
+ALTER TABLE subscriptions DROP CONSTRAINT subscriptions_period_excl;
+
+DROP INDEX subscriptions_user_id_idx;
+
+ALTER TABLE subscriptions DROP COLUMN user_id;
+
+ALTER TABLE subscriptions RENAME COLUMN user_serial_id TO user_id;
+
+ALTER TABLE subscriptions ALTER COLUMN user_id SET NOT NULL;
+
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+
+COMMENT ON COLUMN subscriptions.user_id IS 'Reference to users.id';
+
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_period_excl EXCLUDE USING gist (
+    user_id WITH =,
+    service_id WITH =,
+    tsrange(date_trunc('month', start_date AT TIME ZONE 'UTC'), date_trunc('month', end_date AT TIME ZONE 'UTC'), '[]') WITH &&
+) WHERE (deleted_at IS NULL);
+
+ALTER TABLE users ALTER COLUMN login SET NOT NULL;
+ALTER TABLE users ALTER COLUMN password_hash SET NOT NULL;
+
+ALTER TABLE users DROP CONSTRAINT users_uuid_key;
@@ -0,0 +1,33 @@
+-- Users are managed outside of the service and are known here by UUID only
+ALTER TABLE users ADD CONSTRAINT users_uuid_key UNIQUE (uuid);
+
+-- Users provisioned on the first subscription have no credentials
+ALTER TABLE users ALTER COLUMN login DROP NOT NULL;
+ALTER TABLE users ALTER COLUMN password_hash DROP NOT NULL;
+
+ALTER TABLE subscriptions ADD COLUMN user_uuid UUID NULL;
+
+UPDATE subscriptions SET user_uuid = users.uuid FROM users WHERE users.id = subscriptions.user_id;
+
+-- Exclusion constraint and foreign key depend on the column and go away with it
+ALTER TABLE subscriptions DROP CONSTRAINT subscriptions_period_excl;
+
+ALTER TABLE subscriptions DROP COLUMN user_id;
+
+ALTER TABLE subscriptions RENAME COLUMN user_uuid TO user_id;
+
+ALTER TABLE subscriptions ALTER COLUMN user_id SET NOT NULL;
+
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users (uuid) ON DELETE CASCADE;
+
+COMMENT ON COLUMN subscriptions.user_id IS 'Reference to users.uuid';
+
+-- Lists and reports filter on the user without joining users
+CREATE INDEX subscriptions_user_id_idx ON subscriptions (user_id);
+
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_period_excl EXCLUDE USING gist (
+    user_id WITH =,
+    service_id WITH =,
+    tsrange(date_trunc('month', start_date AT TIME ZONE 'UTC'), date_trunc('month', end_date AT TIME ZONE 'UTC'), '[]') WITH &&
+) WHERE (deleted_at IS NULL);
@@ -0,0 +1,20 @@
+CREATE TABLE auth_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(uuid) ON DELETE CASCADE,
+    refresh_token_hash CHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ NULL,
+    CONSTRAINT auth_sessions_refresh_token_hash_key UNIQUE (refresh_token_hash)
+);
+
+CREATE INDEX auth_sessions_user_id_idx ON auth_sessions (user_id);
+CREATE INDEX auth_sessions_expires_at_idx ON auth_sessions (expires_at);
+
+COMMENT ON TABLE auth_sessions IS 'Sessions started by login, access and refresh tokens are bound to them';
+COMMENT ON COLUMN auth_sessions.id IS 'Primary key, sid claim of the access token';
+COMMENT ON COLUMN auth_sessions.user_id IS 'Reference to users.uuid';
+COMMENT ON COLUMN auth_sessions.refresh_token_hash IS 'SHA-256 of the current refresh token';
+COMMENT ON COLUMN auth_sessions.created_at IS 'Date of the login';
+COMMENT ON COLUMN auth_sessions.expires_at IS 'Date after which the refresh token is not accepted';
+COMMENT ON COLUMN auth_sessions.revoked_at IS 'Date of the logout';
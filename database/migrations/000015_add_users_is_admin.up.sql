@@ -0,0 +1,3 @@
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN users.is_admin IS 'Admins see soft deleted subscriptions';
@@ -15,6 +15,45 @@ import (
 )
 
 type Seeder struct {
+	// The minimum number of AuthSessions to seed
+	MinAuthSessionsToSeed int
+	// RandomAuthSession creates a random models.AuthSession
+	// It does not need to add relationships.
+	// If one is not set, defaultRandomAuthSession() is used
+	RandomAuthSession func() (*models.AuthSession, error)
+	// AfterAuthSessionsAdded runs after all AuthSessions are added
+	AfterAuthSessionsAdded func(ctx context.Context) error
+	// defaultAuthSessionForeignKeySetter() is used if this is not set
+	// setting this means that the xxxPerxxx settings cannot be guaranteed
+	AuthSessionForeignKeySetter func(i int, o *models.AuthSession, allUsers models.UserSlice) error
+
+	// The minimum number of ExchangeRates to seed
+	MinExchangeRatesToSeed int
+	// RandomExchangeRate creates a random models.ExchangeRate
+	// It does not need to add relationships.
+	// If one is not set, defaultRandomExchangeRate() is used
+	RandomExchangeRate func() (*models.ExchangeRate, error)
+	// AfterExchangeRatesAdded runs after all ExchangeRates are added
+	AfterExchangeRatesAdded func(ctx context.Context) error
+
+	// The minimum number of IdempotencyKeys to seed
+	MinIdempotencyKeysToSeed int
+	// RandomIdempotencyKey creates a random models.IdempotencyKey
+	// It does not need to add relationships.
+	// If one is not set, defaultRandomIdempotencyKey() is used
+	RandomIdempotencyKey func() (*models.IdempotencyKey, error)
+	// AfterIdempotencyKeysAdded runs after all IdempotencyKeys are added
+	AfterIdempotencyKeysAdded func(ctx context.Context) error
+
+	// The minimum number of Services to seed
+	MinServicesToSeed int
+	// RandomService creates a random models.Service
+	// It does not need to add relationships.
+	// If one is not set, defaultRandomService() is used
+	RandomService func() (*models.Service, error)
+	// AfterServicesAdded runs after all Services are added
+	AfterServicesAdded func(ctx context.Context) error
+
 	// The minimum number of Subscriptions to seed
 	MinSubscriptionsToSeed int
 	// RandomSubscription creates a random models.Subscription
@@ -25,7 +64,31 @@ type Seeder struct {
 	AfterSubscriptionsAdded func(ctx context.Context) error
 	// defaultSubscriptionForeignKeySetter() is used if this is not set
 	// setting this means that the xxxPerxxx settings cannot be guaranteed
-	SubscriptionForeignKeySetter func(i int, o *models.Subscription, allUsers models.UserSlice) error
+	SubscriptionForeignKeySetter func(i int, o *models.Subscription, allServices models.ServiceSlice, allUsers models.UserSlice) error
+
+	// The minimum number of SubscriptionAudits to seed
+	MinSubscriptionAuditsToSeed int
+	// RandomSubscriptionAudit creates a random models.SubscriptionAudit
+	// It does not need to add relationships.
+	// If one is not set, defaultRandomSubscriptionAudit() is used
+	RandomSubscriptionAudit func() (*models.SubscriptionAudit, error)
+	// AfterSubscriptionAuditsAdded runs after all SubscriptionAudits are added
+	AfterSubscriptionAuditsAdded func(ctx context.Context) error
+	// defaultSubscriptionAuditForeignKeySetter() is used if this is not set
+	// setting this means that the xxxPerxxx settings cannot be guaranteed
+	SubscriptionAuditForeignKeySetter func(i int, o *models.SubscriptionAudit, allSubscriptions models.SubscriptionSlice) error
+
+	// The minimum number of SubscriptionPrices to seed
+	MinSubscriptionPricesToSeed int
+	// RandomSubscriptionPrice creates a random models.SubscriptionPrice
+	// It does not need to add relationships.
+	// If one is not set, defaultRandomSubscriptionPrice() is used
+	RandomSubscriptionPrice func() (*models.SubscriptionPrice, error)
+	// AfterSubscriptionPricesAdded runs after all SubscriptionPrices are added
+	AfterSubscriptionPricesAdded func(ctx context.Context) error
+	// defaultSubscriptionPriceForeignKeySetter() is used if this is not set
+	// setting this means that the xxxPerxxx settings cannot be guaranteed
+	SubscriptionPriceForeignKeySetter func(i int, o *models.SubscriptionPrice, allSubscriptions models.SubscriptionSlice) error
 
 	// The minimum number of Users to seed
 	MinUsersToSeed int
@@ -36,7 +99,11 @@ type Seeder struct {
 	// AfterUsersAdded runs after all Users are added
 	AfterUsersAdded func(ctx context.Context) error
 
-	SubscriptionsPerUser int
+	AuthSessionsPerUser               int
+	SubscriptionAuditsPerSubscription int
+	SubscriptionPricesPerSubscription int
+	SubscriptionsPerService           int
+	SubscriptionsPerUser              int
 
 	// Number of times to retry getting a unique relationship in many-to-many relationships
 	Retries int
@@ -49,16 +116,98 @@ func (s Seeder) Run(ctx context.Context, exec boil.ContextExecutor) error {
 	ctxMain, cancelMain := context.WithCancel(ctx)
 	defer cancelMain()
 
+	ctxAuthSessions, cancelAuthSessions := context.WithCancel(ctxMain)
+	ctxExchangeRates, cancelExchangeRates := context.WithCancel(ctxMain)
+	ctxIdempotencyKeys, cancelIdempotencyKeys := context.WithCancel(ctxMain)
+	ctxServices, cancelServices := context.WithCancel(ctxMain)
+	ctxSubscriptionAudits, cancelSubscriptionAudits := context.WithCancel(ctxMain)
+	ctxSubscriptionPrices, cancelSubscriptionPrices := context.WithCancel(ctxMain)
 	ctxSubscriptions, cancelSubscriptions := context.WithCancel(ctxMain)
 	ctxUsers, cancelUsers := context.WithCancel(ctxMain)
 
-	errChan := make(chan error, 2)
+	errChan := make(chan error, 8)
+
+	// RunAuthSessionsSeed()
+	wg.Add(1)
+	go func() {
+		defer cancelAuthSessions()
+		defer wg.Done()
+		<-ctxUsers.Done()
+
+		if err := s.seedAuthSessions(ctxAuthSessions, exec); err != nil {
+			errChan <- err
+			cancelMain()
+		}
+	}()
+
+	// RunExchangeRatesSeed()
+	wg.Add(1)
+	go func() {
+		defer cancelExchangeRates()
+		defer wg.Done()
+
+		if err := s.seedExchangeRates(ctxExchangeRates, exec); err != nil {
+			errChan <- err
+			cancelMain()
+		}
+	}()
+
+	// RunIdempotencyKeysSeed()
+	wg.Add(1)
+	go func() {
+		defer cancelIdempotencyKeys()
+		defer wg.Done()
+
+		if err := s.seedIdempotencyKeys(ctxIdempotencyKeys, exec); err != nil {
+			errChan <- err
+			cancelMain()
+		}
+	}()
+
+	// RunServicesSeed()
+	wg.Add(1)
+	go func() {
+		defer cancelServices()
+		defer wg.Done()
+
+		if err := s.seedServices(ctxServices, exec); err != nil {
+			errChan <- err
+			cancelMain()
+		}
+	}()
+
+	// RunSubscriptionAuditsSeed()
+	wg.Add(1)
+	go func() {
+		defer cancelSubscriptionAudits()
+		defer wg.Done()
+		<-ctxSubscriptions.Done()
+
+		if err := s.seedSubscriptionAudits(ctxSubscriptionAudits, exec); err != nil {
+			errChan <- err
+			cancelMain()
+		}
+	}()
+
+	// RunSubscriptionPricesSeed()
+	wg.Add(1)
+	go func() {
+		defer cancelSubscriptionPrices()
+		defer wg.Done()
+		<-ctxSubscriptions.Done()
+
+		if err := s.seedSubscriptionPrices(ctxSubscriptionPrices, exec); err != nil {
+			errChan <- err
+			cancelMain()
+		}
+	}()
 
 	// RunSubscriptionsSeed()
 	wg.Add(1)
 	go func() {
 		defer cancelSubscriptions()
 		defer wg.Done()
+		<-ctxServices.Done()
 		<-ctxUsers.Done()
 
 		if err := s.seedSubscriptions(ctxSubscriptions, exec); err != nil {
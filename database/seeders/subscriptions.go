@@ -18,17 +18,25 @@ import (
 )
 
 var (
-	subscriptionColumnsWithDefault = []string{"id", "end_date", "created_at"}
-	subscriptionDBTypes            = map[string]string{`ID`: `integer`, `UserID`: `integer`, `ServiceName`: `character varying`, `Price`: `integer`, `StartDate`: `timestamp with time zone`, `EndDate`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`}
+	subscriptionColumnsWithDefault = []string{"id", "end_date", "created_at", "deleted_at", "version", "uuid"}
+	subscriptionDBTypes            = map[string]string{`ID`: `integer`, `UserID`: `uuid`, `StartDate`: `timestamp with time zone`, `EndDate`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `ServiceID`: `integer`, `DeletedAt`: `timestamp with time zone`, `Version`: `integer`, `UUID`: `uuid`}
 )
 
-func defaultSubscriptionForeignKeySetter(i int, o *models.Subscription, allUsers models.UserSlice) error {
+func defaultSubscriptionForeignKeySetter(i int, o *models.Subscription, allServices models.ServiceSlice, allUsers models.UserSlice) error {
+	if len(allServices) > 0 {
+		// set service
+		ServiceKey := int(math.Mod(float64(i), float64(len(allServices))))
+		service := allServices[ServiceKey]
+
+		o.ServiceID = service.ID
+
+	}
 	if len(allUsers) > 0 {
 		// set user
 		UserKey := int(math.Mod(float64(i), float64(len(allUsers))))
 		user := allUsers[UserKey]
 
-		o.UserID = user.ID
+		o.UserID = user.UUID
 
 	}
 	return nil
@@ -58,11 +66,20 @@ func (s Seeder) seedSubscriptions(ctx context.Context, exec boil.ContextExecutor
 		fkFunc = defaultSubscriptionForeignKeySetter
 	}
 
+	services, err := models.Services().All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("error getting services: %w", err)
+	}
+
 	users, err := models.Users().All(ctx, exec)
 	if err != nil {
 		return fmt.Errorf("error getting users: %w", err)
 	}
 
+	if s.SubscriptionsPerService*len(services) > SubscriptionsToAdd {
+		SubscriptionsToAdd = s.SubscriptionsPerService * len(services)
+	}
+
 	if s.SubscriptionsPerUser*len(users) > SubscriptionsToAdd {
 		SubscriptionsToAdd = s.SubscriptionsPerUser * len(users)
 	}
@@ -75,7 +92,7 @@ func (s Seeder) seedSubscriptions(ctx context.Context, exec boil.ContextExecutor
 		}
 
 		// Set foreign keys
-		err = fkFunc(i, o, users)
+		err = fkFunc(i, o, services, users)
 		if err != nil {
 			return fmt.Errorf("unable to get set foreign keys for Subscription: %w", err)
 		}
@@ -107,11 +124,13 @@ var _ = strconv.IntSize
 
 // subscription is here to prevent erros due to driver "BasedOnType" imports.
 type subscription struct {
-	ID          int
-	UserID      int
-	ServiceName string
-	Price       int
-	StartDate   time.Time
-	EndDate     null.Time
-	CreatedAt   time.Time
+	ID        int
+	UserID    string
+	StartDate time.Time
+	EndDate   null.Time
+	CreatedAt time.Time
+	ServiceID int
+	DeletedAt null.Time
+	Version   int
+	UUID      string
 }
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/randomize"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
@@ -17,8 +18,8 @@ import (
 )
 
 var (
-	userColumnsWithDefault = []string{"id", "created_at", "uuid"}
-	userDBTypes            = map[string]string{`ID`: `integer`, `Login`: `character varying`, `PasswordHash`: `character`, `CreatedAt`: `timestamp with time zone`, `UUID`: `uuid`}
+	userColumnsWithDefault = []string{"id", "created_at", "uuid", "is_admin"}
+	userDBTypes            = map[string]string{`ID`: `integer`, `Login`: `character varying`, `PasswordHash`: `character`, `CreatedAt`: `timestamp with time zone`, `UUID`: `uuid`, `IsAdmin`: `boolean`}
 )
 
 // defaultRandomUser creates a random model.User
@@ -75,8 +76,8 @@ var _ = strconv.IntSize
 // user is here to prevent erros due to driver "BasedOnType" imports.
 type user struct {
 	ID           int
-	Login        string
-	PasswordHash string
+	Login        null.String
+	PasswordHash null.String
 	CreatedAt    time.Time
 	UUID         string
 }
@@ -0,0 +1,170 @@
+// Audit trail of subscription changes
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
+	"github.com/zeleniy/test28/internal/logging"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+const (
+	OperationInsert  = "insert"
+	OperationUpdate  = "update"
+	OperationDelete  = "delete"
+	OperationRestore = "restore"
+)
+
+// Months are recorded in the same MM-YYYY format the API uses
+const monthLayout = "01-2006"
+
+type contextKey string
+
+const actorKey contextKey = "audit_actor"
+
+// Field value before and after the change, nil for the missing one
+type Change struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// Store the actor making changes in the context
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// Get the actor stored in the context, empty when changes are made anonymously
+func Actor(ctx context.Context) string {
+
+	actor, _ := ctx.Value(actorKey).(string)
+
+	return actor
+}
+
+// Audited subscription fields. Prices are recorded one field per effective month
+func Snapshot(subscription *models.Subscription, prices models.SubscriptionPriceSlice) map[string]interface{} {
+
+	snapshot := map[string]interface{}{
+		"user_id":    subscription.UserID,
+		"service_id": subscription.ServiceID,
+		"start_date": subscription.StartDate.Format(monthLayout),
+		"end_date":   formatMonth(subscription.EndDate),
+		"deleted_at": formatTime(subscription.DeletedAt),
+	}
+
+	for _, price := range prices {
+		snapshot["prices["+price.EffectiveFrom.Format(monthLayout)+"]"] = map[string]interface{}{
+			"price":    price.Price,
+			"currency": price.Currency,
+		}
+	}
+
+	return snapshot
+}
+
+// Get fields differing between two snapshots, either of which may be nil
+func Diff(before, after map[string]interface{}) map[string]Change {
+
+	changes := make(map[string]Change)
+
+	for field, value := range before {
+		if !reflect.DeepEqual(value, after[field]) {
+			changes[field] = Change{Before: value, After: after[field]}
+		}
+	}
+
+	for field, value := range after {
+		if _, ok := before[field]; !ok {
+			changes[field] = Change{After: value}
+		}
+	}
+
+	return changes
+}
+
+// Build audit entry of the change made in the request context, nil when nothing is changed
+func NewEntry(ctx context.Context, subscription *models.Subscription, operation string, before, after map[string]interface{}) (*models.SubscriptionAudit, error) {
+
+	changes := Diff(before, after)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := Actor(ctx)
+	requestID := logging.RequestID(ctx)
+
+	return &models.SubscriptionAudit{
+		SubscriptionID:   null.IntFrom(subscription.ID),
+		SubscriptionUUID: subscription.UUID,
+		Operation:        operation,
+		Actor:            null.NewString(actor, actor != ""),
+		RequestID:        null.NewString(requestID, requestID != ""),
+		Changes:          data,
+	}, nil
+}
+
+// Record subscription change. Must be called with the executor of the transaction making the change
+func Record(ctx context.Context, exec boil.ContextExecutor, subscription *models.Subscription, operation string, before, after map[string]interface{}) error {
+
+	entry, err := NewEntry(ctx, subscription, operation, before, after)
+	if err != nil || entry == nil {
+		return err
+	}
+
+	return entry.Insert(ctx, exec, boil.Infer())
+}
+
+// Record insertion of many subscriptions with a single query
+func RecordInserts(ctx context.Context, exec boil.ContextExecutor, subscriptionIDs []int64, subscriptionUUIDs []string, snapshots []map[string]interface{}) error {
+
+	changes := make([]string, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		data, err := json.Marshal(Diff(nil, snapshot))
+		if err != nil {
+			return err
+		}
+		changes[i] = string(data)
+	}
+
+	actor := Actor(ctx)
+	requestID := logging.RequestID(ctx)
+
+	_, err := exec.ExecContext(ctx, `INSERT INTO subscription_audits (subscription_id, subscription_uuid, operation, actor, request_id, changes)
+		SELECT entries.subscription_id, entries.subscription_uuid, $4, $5, $6, entries.changes
+		FROM UNNEST($1::int[], $2::uuid[], $3::jsonb[]) AS entries(subscription_id, subscription_uuid, changes)`,
+		pq.Array(subscriptionIDs), pq.Array(subscriptionUUIDs), pq.Array(changes), OperationInsert,
+		null.NewString(actor, actor != ""), null.NewString(requestID, requestID != ""),
+	)
+
+	return err
+}
+
+func formatMonth(month null.Time) interface{} {
+
+	if !month.Valid {
+		return nil
+	}
+
+	return month.Time.Format(monthLayout)
+}
+
+func formatTime(moment null.Time) interface{} {
+
+	if !moment.Valid {
+		return nil
+	}
+
+	return moment.Time.UTC().Format(time.RFC3339)
+}
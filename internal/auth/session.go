@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zeleniy/test28/internal/models"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Compared when the login is not found, so that the response time does not reveal the existing logins
+const unknownUserHash = "$2a$10$LP9XhZfkc7HSHriT3c9n2evDAGevxARv05rkfOat.BXCCQ/cVsbpa"
+
+// Random bytes of the refresh token
+const refreshTokenSize = 32
+
+// Tokens issued for the session
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	// Access token expiry, refresh token is valid as long as the session
+	ExpiresAt time.Time
+}
+
+// Find the user by login and check the password against the bcrypt hash
+func Login(ctx context.Context, exec boil.ContextExecutor, login, password string) (*models.User, error) {
+
+	user, err := models.Users(models.UserWhere.Login.EQ(null.StringFrom(login))).One(ctx, exec)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	hash := unknownUserHash
+	if user != nil && user.PasswordHash.Valid {
+		hash = user.PasswordHash.String
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil || hash == unknownUserHash {
+		return nil, ErrInvalidCredentials
+	}
+
+	return user, nil
+}
+
+// Start the session of the user
+func (i *Issuer) Start(ctx context.Context, exec boil.ContextExecutor, userID string) (Tokens, error) {
+
+	refreshToken, err := newRefreshToken()
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	session := &models.AuthSession{
+		UserID:           userID,
+		RefreshTokenHash: hashToken(refreshToken),
+		ExpiresAt:        time.Now().Add(i.refreshTTL),
+	}
+
+	if err := session.Insert(ctx, exec, boil.Infer()); err != nil {
+		return Tokens{}, err
+	}
+
+	return i.issue(session, refreshToken)
+}
+
+// Exchange the refresh token for the new pair. Refresh token is single use, the one presented is replaced
+// and the session is prolonged
+func (i *Issuer) Refresh(ctx context.Context, exec boil.ContextExecutor, refreshToken string) (Tokens, error) {
+
+	nextToken, err := newRefreshToken()
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	var session models.AuthSession
+
+	err = queries.Raw(`UPDATE auth_sessions SET refresh_token_hash = $1, expires_at = $2
+		WHERE refresh_token_hash = $3 AND revoked_at IS NULL AND expires_at > NOW()
+		RETURNING *`,
+		hashToken(nextToken), time.Now().Add(i.refreshTTL), hashToken(refreshToken),
+	).Bind(ctx, exec, &session)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return Tokens{}, ErrInvalidToken
+	}
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	return i.issue(&session, nextToken)
+}
+
+// Get the user of the access token, unless the session is revoked or expired
+func (i *Issuer) Authenticate(ctx context.Context, exec boil.ContextExecutor, accessToken string) (*models.User, Claims, error) {
+
+	claims, err := i.Verify(accessToken, time.Now())
+	if err != nil {
+		return nil, claims, err
+	}
+
+	user, err := models.Users(
+		qm.InnerJoin("auth_sessions ON auth_sessions.user_id = users.uuid"),
+		qm.Where("auth_sessions.id = ?", claims.SessionID),
+		qm.Where("auth_sessions.user_id = ?", claims.Subject),
+		qm.Where("auth_sessions.revoked_at IS NULL"),
+		qm.Where("auth_sessions.expires_at > NOW()"),
+	).One(ctx, exec)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, claims, ErrInvalidToken
+	}
+
+	return user, claims, err
+}
+
+// Revoke the session, neither its access nor refresh token is accepted any more
+func Revoke(ctx context.Context, exec boil.ContextExecutor, sessionID string) error {
+
+	_, err := models.AuthSessions(
+		models.AuthSessionWhere.ID.EQ(sessionID),
+		models.AuthSessionWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, exec, models.M{models.AuthSessionColumns.RevokedAt: time.Now()})
+
+	return err
+}
+
+// Delete expired sessions
+func Purge(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+
+	return models.AuthSessions(qm.Where("expires_at < NOW()")).DeleteAll(ctx, exec)
+}
+
+func (i *Issuer) issue(session *models.AuthSession, refreshToken string) (Tokens, error) {
+
+	now := time.Now()
+	expiresAt := now.Add(i.accessTTL)
+
+	accessToken, err := i.Sign(Claims{
+		SessionID: session.ID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   session.UserID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+
+	return Tokens{AccessToken: accessToken, RefreshToken: refreshToken, ExpiresAt: expiresAt}, err
+}
+
+func newRefreshToken() (string, error) {
+
+	token := make([]byte, refreshTokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Only the hash of the refresh token is stored, so that the leaked table does not give access
+func hashToken(token string) string {
+
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,101 @@
+// Token authentication of the users having login and password
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zeleniy/test28/internal/config"
+)
+
+var (
+	ErrInvalidToken       = errors.New("invalid token")
+	ErrExpiredToken       = errors.New("token is expired")
+	ErrInvalidCredentials = errors.New("invalid login or password")
+)
+
+// Claims of the access token, the subject is UUID of the user
+type Claims struct {
+	SessionID string `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+// Issuer of the tokens signed with the current key. Key ID in the token header selects the verification key,
+// so the tokens signed before the key rotation stay valid while the retired key is configured
+type Issuer struct {
+	keyID      string
+	keys       map[string][]byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// Create issuer with the keys of the configuration
+func NewIssuer(cfg config.AuthConfig) (*Issuer, error) {
+
+	issuer := &Issuer{
+		keyID:      cfg.KeyID,
+		keys:       make(map[string][]byte, len(cfg.Keys)),
+		accessTTL:  cfg.AccessTTL,
+		refreshTTL: cfg.RefreshTTL,
+	}
+
+	for _, key := range cfg.Keys {
+		id, secret, ok := strings.Cut(key, ":")
+		if !ok || id == "" || secret == "" {
+			return nil, fmt.Errorf("%s must be a list of id:secret pairs", config.EnvName("auth.keys"))
+		}
+		issuer.keys[id] = []byte(secret)
+	}
+
+	if _, ok := issuer.keys[cfg.KeyID]; !ok {
+		return nil, fmt.Errorf("%s %q is not found in %s", config.EnvName("auth.key_id"), cfg.KeyID, config.EnvName("auth.keys"))
+	}
+
+	return issuer, nil
+}
+
+// Sign the claims with the current key
+func (i *Issuer) Sign(claims Claims) (string, error) {
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = i.keyID
+
+	return token.SignedString(i.keys[i.keyID])
+}
+
+// Get claims of the token signed with any of the keys, unless it is expired at the moment
+func (i *Issuer) Verify(token string, now time.Time) (Claims, error) {
+
+	var claims Claims
+
+	_, err := jwt.ParseWithClaims(token, &claims, i.key,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+
+	switch {
+	case err == nil:
+		return claims, nil
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return claims, ErrExpiredToken
+	default:
+		return claims, ErrInvalidToken
+	}
+}
+
+// Find the verification key by the key ID in the token header
+func (i *Issuer) key(token *jwt.Token) (interface{}, error) {
+
+	keyID, _ := token.Header["kid"].(string)
+
+	key, ok := i.keys[keyID]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	return key, nil
+}
@@ -0,0 +1,273 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/spf13/viper"
+)
+
+// Application configuration. Sources in order of increasing precedence:
+// defaults, config.yaml, .env file and environment variables.
+// Nested keys map to environment variables joined by underscore, e.g. db.max_open_conns is DB_MAX_OPEN_CONNS
+type Config struct {
+	HTTP HTTPConfig `mapstructure:"http"`
+	Gin  GinConfig  `mapstructure:"gin"`
+	DB   DBConfig   `mapstructure:"db"`
+	Log  LogConfig  `mapstructure:"log"`
+
+	Subscriptions SubscriptionsConfig `mapstructure:"subscriptions"`
+	Idempotency   IdempotencyConfig   `mapstructure:"idempotency"`
+	Auth          AuthConfig          `mapstructure:"auth"`
+}
+
+type HTTPConfig struct {
+	Address           string        `mapstructure:"address" validate:"required,hostname_port"`
+	ReadTimeout       time.Duration `mapstructure:"read_timeout" validate:"min=0"`
+	ReadHeaderTimeout time.Duration `mapstructure:"read_header_timeout" validate:"min=0"`
+	WriteTimeout      time.Duration `mapstructure:"write_timeout" validate:"min=0"`
+	IdleTimeout       time.Duration `mapstructure:"idle_timeout" validate:"min=0"`
+	// Deadline for in-flight requests to complete after the shutdown signal
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout" validate:"gt=0"`
+}
+
+type GinConfig struct {
+	Mode string `mapstructure:"mode" validate:"oneof=debug release test"`
+}
+
+type DBConfig struct {
+	// Full connection URL, takes precedence over the separate parts
+	URL             string        `mapstructure:"url"`
+	Host            string        `mapstructure:"host" validate:"required_without=URL"`
+	Port            int           `mapstructure:"port" validate:"min=1,max=65535"`
+	User            string        `mapstructure:"user" validate:"required_without=URL"`
+	Pass            string        `mapstructure:"pass"`
+	Name            string        `mapstructure:"name" validate:"required_without=URL"`
+	SSLMode         string        `mapstructure:"sslmode" validate:"oneof=disable allow prefer require verify-ca verify-full"`
+	ConnectTimeout  time.Duration `mapstructure:"connect_timeout" validate:"min=0"`
+	MaxOpenConns    int           `mapstructure:"max_open_conns" validate:"min=0"`
+	MaxIdleConns    int           `mapstructure:"max_idle_conns" validate:"min=0"`
+	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime" validate:"min=0"`
+	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time" validate:"min=0"`
+}
+
+type LogConfig struct {
+	Level  string `mapstructure:"level" validate:"oneof=debug info warn error"`
+	Format string `mapstructure:"format" validate:"oneof=json text"`
+}
+
+type SubscriptionsConfig struct {
+	// Soft deleted subscriptions older than this are purged for good
+	Retention time.Duration `mapstructure:"retention" validate:"gt=0"`
+	// Changes without If-Match header are rejected with 428
+	IfMatchRequired bool `mapstructure:"if_match_required"`
+	// Integer IDs are still accepted in the paths along with UUIDs, with Deprecation header
+	IntegerIDs bool `mapstructure:"integer_ids"`
+}
+
+type IdempotencyConfig struct {
+	// Response stored for the Idempotency-Key is replayed during this period
+	TTL time.Duration `mapstructure:"ttl" validate:"gt=0"`
+	// Duplicate of the request in progress waits for it this long and is rejected with 409 then
+	Wait time.Duration `mapstructure:"wait" validate:"min=0"`
+	// Key of the request which never completed, e.g. because the server crashed, is released after this period
+	LockTimeout time.Duration `mapstructure:"lock_timeout" validate:"gt=0"`
+}
+
+type AuthConfig struct {
+	// Token signing keys in id:secret form, comma separated in the environment. Keys retired by rotation are kept
+	// until the tokens signed with them expire
+	Keys []string `mapstructure:"keys" validate:"required"`
+	// ID of the key signing new tokens
+	KeyID string `mapstructure:"key_id" validate:"required"`
+	// Lifetime of the access token, logout revokes it before that
+	AccessTTL time.Duration `mapstructure:"access_ttl" validate:"gt=0"`
+	// Session expires unless the refresh token is used during this period
+	RefreshTTL time.Duration `mapstructure:"refresh_ttl" validate:"gt=0"`
+}
+
+var defaults = map[string]interface{}{
+	"http.address":                    ":8080",
+	"http.read_timeout":               15 * time.Second,
+	"http.read_header_timeout":        5 * time.Second,
+	"http.write_timeout":              60 * time.Second,
+	"http.idle_timeout":               120 * time.Second,
+	"http.shutdown_timeout":           30 * time.Second,
+	"gin.mode":                        "release",
+	"db.url":                          "",
+	"db.host":                         "",
+	"db.port":                         5432,
+	"db.user":                         "",
+	"db.pass":                         "",
+	"db.name":                         "",
+	"db.sslmode":                      "disable",
+	"db.connect_timeout":              5 * time.Second,
+	"db.max_open_conns":               10,
+	"db.max_idle_conns":               5,
+	"db.conn_max_lifetime":            30 * time.Minute,
+	"db.conn_max_idle_time":           5 * time.Minute,
+	"log.level":                       "info",
+	"log.format":                      "json",
+	"subscriptions.retention":         90 * 24 * time.Hour,
+	"subscriptions.if_match_required": false,
+	"subscriptions.integer_ids":       true,
+	"idempotency.ttl":                 24 * time.Hour,
+	"idempotency.wait":                5 * time.Second,
+	"idempotency.lock_timeout":        time.Minute,
+	"auth.keys":                       []string{},
+	"auth.key_id":                     "",
+	"auth.access_ttl":                 15 * time.Minute,
+	"auth.refresh_ttl":                30 * 24 * time.Hour,
+}
+
+// Load configuration from the files in the directory and environment
+func Load(directory string) (*Config, error) {
+
+	v := viper.New()
+
+	for key, value := range defaults {
+		v.SetDefault(key, value)
+	}
+
+	v.SetConfigFile(directory + "/config.yaml")
+
+	if err := v.ReadInConfig(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("cannot read config.yaml: %w", err)
+	}
+
+	dotEnv, err := readDotEnv(directory+"/.env", v.AllKeys())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.MergeConfigMap(dotEnv); err != nil {
+		return nil, err
+	}
+
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	var config Config
+
+	if err := v.Unmarshal(&config); err != nil {
+		return nil, fmt.Errorf("cannot decode configuration: %w", err)
+	}
+
+	return &config, nil
+}
+
+// Read .env file into nested map of the known keys
+func readDotEnv(path string, keys []string) (map[string]interface{}, error) {
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("env")
+
+	if err := v.ReadInConfig(); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot read .env: %w", err)
+	}
+
+	result := map[string]interface{}{}
+
+	for _, key := range keys {
+		envKey := strings.ToLower(EnvName(key))
+		if !v.IsSet(envKey) {
+			continue
+		}
+
+		section, name, _ := strings.Cut(key, ".")
+		if _, ok := result[section]; !ok {
+			result[section] = map[string]interface{}{}
+		}
+		result[section].(map[string]interface{})[name] = v.Get(envKey)
+	}
+
+	return result, nil
+}
+
+// Environment variable name of the configuration key
+func EnvName(key string) string {
+	return strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// Validate configuration reporting every invalid setting by its environment variable name
+func (c *Config) Validate() error {
+
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		return field.Tag.Get("mapstructure")
+	})
+
+	err := validate.Struct(c)
+
+	var validationErrors validator.ValidationErrors
+
+	if !errors.As(err, &validationErrors) {
+		return err
+	}
+
+	messages := make([]string, 0, len(validationErrors))
+
+	for _, fieldError := range validationErrors {
+		_, key, _ := strings.Cut(fieldError.Namespace(), ".")
+		messages = append(messages, getValidationMessage(EnvName(key), fieldError))
+	}
+
+	return fmt.Errorf("invalid configuration: %s", strings.Join(messages, "; "))
+}
+
+// Human readable description of the failed rule
+func getValidationMessage(name string, fieldError validator.FieldError) string {
+
+	switch fieldError.Tag() {
+	case "required":
+		return name + " is required"
+	case "required_without":
+		return fmt.Sprintf("%s is required when %s is not set", name, EnvName("db."+strings.ToLower(fieldError.Param())))
+	case "oneof":
+		return fmt.Sprintf("%s must be one of [%s], got %q", name, fieldError.Param(), fmt.Sprint(fieldError.Value()))
+	case "hostname_port":
+		return fmt.Sprintf("%s must be in host:port form, got %q", name, fmt.Sprint(fieldError.Value()))
+	case "gt":
+		return fmt.Sprintf("%s must be greater than %s, got %v", name, fieldError.Param(), fieldError.Value())
+	case "min", "max":
+		return fmt.Sprintf("%s must be %s %s, got %v", name, map[string]string{"min": "at least", "max": "at most"}[fieldError.Tag()], fieldError.Param(), fieldError.Value())
+	}
+
+	return fmt.Sprintf("%s fails %q rule", name, fieldError.Tag())
+}
+
+// Database connection string
+func (c DBConfig) DSN() string {
+
+	if c.URL != "" {
+		return c.URL
+	}
+
+	query := url.Values{}
+	query.Set("sslmode", c.SSLMode)
+
+	if c.ConnectTimeout > 0 {
+		query.Set("connect_timeout", strconv.Itoa(int(c.ConnectTimeout.Seconds())))
+	}
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Pass),
+		Host:     c.Host + ":" + strconv.Itoa(c.Port),
+		Path:     "/" + c.Name,
+		RawQuery: query.Encode(),
+	}
+
+	return dsn.String()
+}
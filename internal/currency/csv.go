@@ -0,0 +1,128 @@
+package currency
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/ericlagergren/decimal"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// Columns of the exchange rates file
+var csvColumns = []string{"month", "base", "quote", "rate"}
+
+var codeRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Read exchange rates from CSV file with month (MM-YYYY), base, quote and rate columns.
+// The first row is a header naming the columns, their order is arbitrary
+func ReadRates(reader io.Reader) (models.ExchangeRateSlice, error) {
+
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		positions[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range csvColumns {
+		if _, ok := positions[name]; !ok {
+			return nil, fmt.Errorf("column %q is missing", name)
+		}
+	}
+
+	var rates models.ExchangeRateSlice
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := csvReader.FieldPos(0)
+
+		rate, err := parseRate(record, positions)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+// Build exchange rate from CSV record
+func parseRate(record []string, positions map[string]int) (*models.ExchangeRate, error) {
+
+	field := func(name string) string {
+		return strings.TrimSpace(record[positions[name]])
+	}
+
+	month, err := time.Parse("01-2006", field("month"))
+	if err != nil {
+		return nil, fmt.Errorf("month %q is not in MM-YYYY format", field("month"))
+	}
+
+	base, quote := strings.ToUpper(field("base")), strings.ToUpper(field("quote"))
+
+	for _, code := range []string{base, quote} {
+		if !codeRegexp.MatchString(code) {
+			return nil, fmt.Errorf("currency %q is not an ISO 4217 code", code)
+		}
+	}
+
+	if base == quote {
+		return nil, fmt.Errorf("base and quote currencies are the same")
+	}
+
+	rate, ok := decimal.WithContext(decimalContext).SetString(field("rate"))
+	if !ok || !rate.IsFinite() || rate.Sign() <= 0 {
+		return nil, fmt.Errorf("rate %q is not a positive number", field("rate"))
+	}
+
+	return &models.ExchangeRate{
+		Base:  base,
+		Quote: quote,
+		Month: month,
+		Rate:  types.NewDecimal(rate),
+	}, nil
+}
+
+// Insert exchange rates replacing the known ones for the same currencies and month
+func SaveRates(ctx context.Context, exec boil.ContextExecutor, rates models.ExchangeRateSlice) error {
+
+	conflict := []string{
+		models.ExchangeRateColumns.Base,
+		models.ExchangeRateColumns.Quote,
+		models.ExchangeRateColumns.Month,
+	}
+
+	for _, rate := range rates {
+		err := rate.Upsert(ctx, exec, true, conflict, boil.Whitelist(models.ExchangeRateColumns.Rate), boil.Infer())
+		if err != nil {
+			return fmt.Errorf("%s/%s %s: %w", rate.Base, rate.Quote, rate.Month.Format("01-2006"), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+// ISO 4217 currencies and monthly exchange rates
+package currency
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ericlagergren/decimal"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// Currency of prices and reports when none is given
+const Default = "RUB"
+
+const monthKeyLayout = "2006-01"
+
+// Currencies whose minor unit is not a hundredth of the major one
+var exponents = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// Number of decimal digits of the currency minor unit
+func Exponent(code string) int {
+
+	if exponent, ok := exponents[code]; ok {
+		return exponent
+	}
+
+	return 2
+}
+
+// Rates are multiplied and divided at decimal128 precision, converted amounts are rounded half away from zero
+var decimalContext = func() decimal.Context {
+
+	c := decimal.Context128
+	c.RoundingMode = decimal.ToNearestAway
+
+	return c
+}()
+
+// Convert amount in minor units of one currency to minor units of another one at the rate given
+// in major units. Result is rounded half away from zero
+func Convert(amount int64, from string, to string, rate *decimal.Big) int64 {
+
+	converted := decimal.WithContext(decimalContext).Mul(decimal.New(amount, Exponent(from)), rate)
+	converted.Mul(converted, decimal.New(1, -Exponent(to)))
+
+	result, _ := converted.RoundToInt().Int64()
+
+	return result
+}
+
+type rateKey struct {
+	base  string
+	quote string
+	month string
+}
+
+// Exchange rates indexed by currency pair and month
+type Rates struct {
+	rates map[rateKey]*decimal.Big
+	// Currencies having any rate in the month, the default one goes first
+	currencies map[string][]string
+}
+
+// Index exchange rates
+func NewRates(rates models.ExchangeRateSlice) Rates {
+
+	result := Rates{
+		rates:      make(map[rateKey]*decimal.Big, len(rates)),
+		currencies: make(map[string][]string),
+	}
+
+	for _, rate := range rates {
+		month := rate.Month.UTC().Format(monthKeyLayout)
+		result.rates[rateKey{rate.Base, rate.Quote, month}] = rate.Rate.Big
+		for _, code := range []string{rate.Base, rate.Quote} {
+			if !slices.Contains(result.currencies[month], code) {
+				result.currencies[month] = append(result.currencies[month], code)
+			}
+		}
+	}
+
+	for month, currencies := range result.currencies {
+		slices.Sort(currencies)
+		if i := slices.Index(currencies, Default); i > 0 {
+			result.currencies[month] = append([]string{Default}, slices.Delete(currencies, i, i+1)...)
+		}
+	}
+
+	return result
+}
+
+// Rate of the base currency in the quote currency in the month. The reverse pair is used when there is no direct one,
+// and the cross rate through a currency both are quoted against when there is neither
+func (r Rates) Rate(base string, quote string, month time.Time) (*decimal.Big, bool) {
+
+	if base == quote {
+		return decimal.New(1, 0), true
+	}
+
+	key := month.UTC().Format(monthKeyLayout)
+
+	if rate, ok := r.pairRate(base, quote, key); ok {
+		return rate, true
+	}
+
+	for _, cross := range r.currencies[key] {
+		if cross == base || cross == quote {
+			continue
+		}
+
+		baseRate, ok := r.pairRate(base, cross, key)
+		if !ok {
+			continue
+		}
+
+		if quoteRate, ok := r.pairRate(cross, quote, key); ok {
+			return decimal.WithContext(decimalContext).Mul(baseRate, quoteRate), true
+		}
+	}
+
+	return nil, false
+}
+
+// Rate of the direct pair or inverted rate of the reverse one
+func (r Rates) pairRate(base string, quote string, month string) (*decimal.Big, bool) {
+
+	if rate, ok := r.rates[rateKey{base, quote, month}]; ok {
+		return rate, true
+	}
+
+	if rate, ok := r.rates[rateKey{quote, base, month}]; ok {
+		return decimal.WithContext(decimalContext).Quo(decimal.New(1, 0), rate), true
+	}
+
+	return nil, false
+}
+
+// Load all rates for the months range, any of them may be needed for the cross rates
+func LoadRates(ctx context.Context, exec boil.ContextExecutor, first time.Time, last time.Time) (Rates, error) {
+
+	rates, err := models.ExchangeRates(
+		models.ExchangeRateWhere.Month.GTE(first),
+		models.ExchangeRateWhere.Month.LTE(last),
+	).All(ctx, exec)
+
+	if err != nil {
+		return Rates{}, err
+	}
+
+	return NewRates(rates), nil
+}
@@ -0,0 +1,40 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/zeleniy/test28/internal/logging"
+)
+
+// Run function within a transaction on the global executor, committing it when function succeeds.
+// Executor which can not begin transactions, e.g. a transaction opened by a test, is used as is
+func Transaction(ctx context.Context, fn func(exec boil.ContextExecutor) error) error {
+
+	db := boil.GetContextDB()
+
+	beginner, ok := db.(boil.ContextBeginner)
+	if !ok {
+		return fn(db)
+	}
+
+	tx, err := beginner.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var exec boil.ContextExecutor = tx
+	if queryLogger, ok := db.(*logging.QueryLogger); ok {
+		exec = logging.NewQueryLogger(tx, queryLogger.Logger())
+	}
+
+	if err := fn(exec); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rollbackErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/auth"
+	auth_request "github.com/zeleniy/test28/internal/http/request/auth"
+	"github.com/zeleniy/test28/internal/http/response"
+	auth_response "github.com/zeleniy/test28/internal/http/response/auth"
+	user_response "github.com/zeleniy/test28/internal/http/response/user"
+)
+
+type AuthController struct {
+	Issuer *auth.Issuer
+}
+
+// Start the session of the user with valid login and password
+func (ctrl *AuthController) Login(c *gin.Context) {
+
+	var loginRequest auth_request.LoginRequest
+
+	if err := c.ShouldBindJSON(&loginRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	user, err := auth.Login(ctx, boil.GetContextDB(), loginRequest.Login, loginRequest.Password)
+
+	if errors.Is(err, auth.ErrInvalidCredentials) {
+		c.Error(response.Unauthorized(err.Error()))
+		return
+	}
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	tokens, err := ctrl.Issuer.Start(ctx, boil.GetContextDB(), user.UUID)
+
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Set("data", map[string]interface{}{
+		"user":   user_response.NewUser(user),
+		"tokens": auth_response.NewTokens(tokens),
+	})
+}
+
+// Exchange the refresh token for the new token pair
+func (ctrl *AuthController) Refresh(c *gin.Context) {
+
+	var refreshRequest auth_request.RefreshRequest
+
+	if err := c.ShouldBindJSON(&refreshRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	tokens, err := ctrl.Issuer.Refresh(c.Request.Context(), boil.GetContextDB(), refreshRequest.RefreshToken)
+
+	if errors.Is(err, auth.ErrInvalidToken) {
+		c.Error(response.Unauthorized("refresh token is invalid, expired or revoked"))
+		return
+	}
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Set("data", map[string]interface{}{
+		"tokens": auth_response.NewTokens(tokens),
+	})
+}
+
+// Revoke the session of the access token along with its refresh token
+func (ctrl *AuthController) Logout(c *gin.Context) {
+
+	if err := auth.Revoke(c.Request.Context(), boil.GetContextDB(), c.GetString("session_id")); err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,23 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/http/response"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// Soft deleted subscriptions are shown to the admins only
+func authorizeWithDeleted(c *gin.Context, withDeleted bool) *response.Error {
+
+	if !withDeleted {
+		return nil
+	}
+
+	user, _ := c.Get("user")
+
+	if admin, ok := user.(*models.User); ok && admin.IsAdmin {
+		return nil
+	}
+
+	return response.Forbidden("with_deleted is available to admins only")
+}
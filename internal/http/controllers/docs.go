@@ -0,0 +1,23 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/api"
+)
+
+type DocsController struct{}
+
+// Get OpenAPI specification
+func (ctrl *DocsController) GetOpenAPI(c *gin.Context) {
+
+	c.Data(http.StatusOK, "application/json", api.OpenAPI)
+}
+
+// Get Swagger UI page rendering the specification
+func (ctrl *DocsController) GetSwaggerUI(c *gin.Context) {
+
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Data(http.StatusOK, "text/html; charset=utf-8", api.SwaggerUI)
+}
@@ -0,0 +1,317 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/http/request"
+	service_request "github.com/zeleniy/test28/internal/http/request/service"
+	"github.com/zeleniy/test28/internal/http/response"
+	service_response "github.com/zeleniy/test28/internal/http/response/service"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+type ServiceController struct{}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// Get services page, optionally filtered by name substring
+func (ctrl *ServiceController) GetServices(c *gin.Context) {
+
+	var listRequest service_request.ListRequest
+
+	if err := c.ShouldBindQuery(&listRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	if listRequest.Limit == 0 {
+		listRequest.Limit = defaultPageLimit
+	}
+
+	var mods []qm.QueryMod
+
+	if listRequest.Name != nil {
+		mods = append(mods, qm.Where("services.name ILIKE ?", "%"+escapeLike(*listRequest.Name)+"%"))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	page, err := models.Services(append(mods,
+		qm.OrderBy("services.name, services.id"),
+		qm.Limit(listRequest.Limit+1),
+		qm.Offset(listRequest.Offset),
+	)...).All(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	pagination := response.Pagination{
+		Limit:  listRequest.Limit,
+		Offset: &listRequest.Offset,
+	}
+
+	if len(page) > listRequest.Limit {
+		page = page[:listRequest.Limit]
+		nextOffset := listRequest.Offset + listRequest.Limit
+		pagination.NextOffset = &nextOffset
+	}
+
+	services := make([]service_response.Service, 0, len(page))
+
+	for _, service := range page {
+		services = append(services, service_response.NewService(service))
+	}
+
+	c.Set("meta", map[string]interface{}{
+		"pagination": pagination,
+	})
+
+	c.Set("data", map[string]interface{}{
+		"services": services,
+	})
+}
+
+// Add service to the catalog
+func (ctrl *ServiceController) CreateService(c *gin.Context) {
+
+	var request service_request.CreateRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	service := models.Service{
+		Name: request.Name,
+	}
+
+	if err := service.Insert(c.Request.Context(), boil.GetContextDB(), boil.Infer()); err != nil {
+		c.Error(response.Database(err, "service not found"))
+		return
+	}
+
+	c.Header("Location", "/services/"+strconv.Itoa(service.ID))
+	c.Status(http.StatusCreated)
+
+	c.Set("data", map[string]interface{}{
+		"service": service_response.NewService(&service),
+	})
+}
+
+// Get service info
+func (ctrl *ServiceController) ReadService(c *gin.Context) {
+
+	var request request.IdRequest
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	service, err := models.FindService(c.Request.Context(), boil.GetContextDB(), request.ID)
+
+	if err != nil {
+		c.Error(response.Database(err, "service not found"))
+		return
+	}
+
+	c.Set("data", map[string]interface{}{
+		"service": service_response.NewService(service),
+	})
+}
+
+// Rename service
+func (ctrl *ServiceController) UpdateService(c *gin.Context) {
+
+	var idRequest request.IdRequest
+
+	if err := c.ShouldBindUri(&idRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	var updateRequest service_request.UpdateRequest
+
+	if err := c.ShouldBindJSON(&updateRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	service, err := models.FindService(ctx, boil.GetContextDB(), idRequest.ID)
+
+	if err != nil {
+		c.Error(response.Database(err, "service not found"))
+		return
+	}
+
+	service.Name = updateRequest.Name
+
+	if _, err := service.Update(ctx, boil.GetContextDB(), boil.Infer()); err != nil {
+		c.Error(response.Database(err, "service not found"))
+		return
+	}
+
+	c.Set("data", map[string]interface{}{
+		"service": service_response.NewService(service),
+	})
+}
+
+// Remove service from the catalog. Services with subscriptions can not be removed
+func (ctrl *ServiceController) DeleteService(c *gin.Context) {
+
+	var request request.IdRequest
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	deleted, err := models.Services(models.ServiceWhere.ID.EQ(request.ID)).
+		DeleteAll(c.Request.Context(), boil.GetContextDB())
+
+	if err != nil {
+		apiError := response.Database(err, "service not found")
+		if apiError.Code == response.CodeConflict {
+			apiError.Message = getServiceConflictMessage(c.Request.Context(), request.ID)
+		}
+		c.Error(apiError)
+		return
+	}
+
+	if deleted == 0 {
+		c.Error(response.NotFound("service not found"))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Explain what keeps the service. Deleted subscriptions block the removal as well until they are purged
+func getServiceConflictMessage(ctx context.Context, serviceID int) string {
+
+	active, err := models.Subscriptions(models.SubscriptionWhere.ServiceID.EQ(serviceID)).Exists(ctx, boil.GetContextDB())
+
+	if err != nil || active {
+		return "service has subscriptions"
+	}
+
+	return "service has deleted subscriptions, it can be removed once they are purged"
+}
+
+// Get users subscribed to the service
+func (ctrl *ServiceController) GetSubscribers(c *gin.Context) {
+
+	var idRequest request.IdRequest
+
+	if err := c.ShouldBindUri(&idRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	var subscribersRequest service_request.SubscribersRequest
+
+	if err := c.ShouldBindQuery(&subscribersRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	if subscribersRequest.Limit == 0 {
+		subscribersRequest.Limit = defaultPageLimit
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	service, err := models.FindService(ctx, boil.GetContextDB(), idRequest.ID)
+
+	if err != nil {
+		c.Error(response.Database(err, "service not found"))
+		return
+	}
+
+	mods := []qm.QueryMod{
+		qm.Load(models.SubscriptionRels.SubscriptionPrices),
+		qm.OrderBy("subscriptions.start_date, subscriptions.id"),
+		qm.Limit(subscribersRequest.Limit + 1),
+		qm.Offset(subscribersRequest.Offset),
+	}
+
+	if subscribersRequest.Active {
+		currentMonth := truncateToMonth(time.Now())
+		mods = append(mods,
+			models.SubscriptionWhere.StartDate.LTE(currentMonth),
+			qm.Expr(
+				models.SubscriptionWhere.EndDate.IsNull(),
+				qm.Or2(models.SubscriptionWhere.EndDate.GTE(null.TimeFrom(currentMonth))),
+			),
+		)
+	}
+
+	page, err := service.Subscriptions(mods...).All(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	pagination := response.Pagination{
+		Limit:  subscribersRequest.Limit,
+		Offset: &subscribersRequest.Offset,
+	}
+
+	if len(page) > subscribersRequest.Limit {
+		page = page[:subscribersRequest.Limit]
+		nextOffset := subscribersRequest.Offset + subscribersRequest.Limit
+		pagination.NextOffset = &nextOffset
+	}
+
+	subscribers := make([]service_response.Subscriber, 0, len(page))
+
+	for _, subscription := range page {
+		subscribers = append(subscribers, service_response.NewSubscriber(subscription))
+	}
+
+	c.Set("meta", map[string]interface{}{
+		"pagination": pagination,
+	})
+
+	c.Set("data", map[string]interface{}{
+		"service":     service_response.NewService(service),
+		"subscribers": subscribers,
+	})
+}
+
+// Find service by ID or by exact name
+func findService(ctx context.Context, serviceID *int, serviceName *string) (*models.Service, error) {
+
+	if serviceID != nil {
+		return models.FindService(ctx, boil.GetContextDB(), *serviceID)
+	}
+
+	if serviceName != nil {
+		return models.Services(models.ServiceWhere.Name.EQ(*serviceName)).One(ctx, boil.GetContextDB())
+	}
+
+	return nil, errors.New("neither service id nor name is given")
+}
+
+// Escape LIKE pattern wildcards
+func escapeLike(value string) string {
+
+	return likeEscaper.Replace(value)
+}
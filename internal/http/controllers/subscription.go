@@ -2,213 +2,1253 @@ package controllers
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
-	"os"
+	"strings"
 	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/zeleniy/test28/internal/audit"
+	"github.com/zeleniy/test28/internal/currency"
+	"github.com/zeleniy/test28/internal/database"
 	"github.com/zeleniy/test28/internal/http/request"
 	subscription_request "github.com/zeleniy/test28/internal/http/request/subscription"
+	"github.com/zeleniy/test28/internal/http/response"
 	subscription_response "github.com/zeleniy/test28/internal/http/response/subscription"
 	"github.com/zeleniy/test28/internal/models"
+	"github.com/zeleniy/test28/internal/pricing"
+	"github.com/zeleniy/test28/internal/provision"
 )
 
-type SubscriptionController struct{}
+type SubscriptionController struct {
+	// PUT, PATCH and DELETE without If-Match header are rejected
+	IfMatchRequired bool
+	// Serial IDs are accepted in the path along with UUIDs
+	IntegerIDs bool
+}
+
+const defaultPageLimit = 20
+
+// Subscription prices ordered so that the first one is in effect in the current month,
+// or is the earliest scheduled one for subscriptions which are not started yet
+const currentPriceOrderSQL = `subscription_prices.effective_from <= NOW() DESC,
+		CASE WHEN subscription_prices.effective_from <= NOW() THEN subscription_prices.effective_from END DESC,
+		subscription_prices.effective_from`
+
+// Price in effect in the current month
+const currentPriceSQL = `(
+	SELECT subscription_prices.price
+	FROM subscription_prices
+	WHERE subscription_prices.subscription_id = subscriptions.id
+	ORDER BY ` + currentPriceOrderSQL + `
+	LIMIT 1
+)`
+
+// Price and currency in effect in the current month joined as current_price
+const currentPriceJoinSQL = `LATERAL (
+	SELECT subscription_prices.price, subscription_prices.currency
+	FROM subscription_prices
+	WHERE subscription_prices.subscription_id = subscriptions.id
+	ORDER BY ` + currentPriceOrderSQL + `
+	LIMIT 1
+) current_price ON TRUE`
+
+// Months in which subscription is active, from the start month to the end month inclusive
+const subscriptionPeriodSQL = `tsrange(
+	date_trunc('month', subscriptions.start_date AT TIME ZONE 'UTC'),
+	date_trunc('month', subscriptions.end_date AT TIME ZONE 'UTC'),
+	'[]'
+)`
+
+// Sort parameter fields mapped to the sorted columns
+var sortableSubscriptionColumns = map[string]string{
+	models.SubscriptionColumns.ID:        "subscriptions.id",
+	models.SubscriptionColumns.ServiceID: "subscriptions.service_id",
+	"service_name":                       "services.name",
+	"price":                              currentPriceSQL,
+	models.SubscriptionColumns.StartDate: "subscriptions.start_date",
+	models.SubscriptionColumns.EndDate:   "subscriptions.end_date",
+	models.SubscriptionColumns.CreatedAt: "subscriptions.created_at",
+}
 
-// Get subscriptions
+// Get subscriptions page
 func (ctrl *SubscriptionController) GetSubscriptions(c *gin.Context) {
 
+	var listRequest subscription_request.ListRequest
+
+	if err := c.ShouldBindQuery(&listRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	if listRequest.Limit == 0 {
+		listRequest.Limit = defaultPageLimit
+	}
+
+	orderBy, err := getSubscriptionsOrder(listRequest.Sort)
+	if err != nil {
+		c.Error(response.InvalidField("sort", "oneof", err.Error()))
+		return
+	}
+
+	if listRequest.Cursor != nil && (listRequest.Offset != nil || listRequest.Sort != nil) {
+		c.Error(response.InvalidField("cursor", "excluded_with", "cursor can not be combined with offset or sort"))
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
-	var subscriptions []subscription_response.UserSubscription
+	pagination := response.Pagination{
+		Limit: listRequest.Limit,
+	}
 
-	err := models.NewQuery(
-		qm.Select("service_name", "price", "uuid", "start_date"),
-		qm.From("subscriptions"),
-		qm.InnerJoin("users on subscriptions.user_id = users.id"),
-	).Bind(ctx, boil.GetContextDB(), &subscriptions)
+	if apiError := authorizeWithDeleted(c, listRequest.WithDeleted); apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	var deletedMods []qm.QueryMod
+	if listRequest.WithDeleted {
+		deletedMods = append(deletedMods, qm.WithDeleted())
+	}
+
+	if listRequest.WithTotal {
+		total, err := models.Subscriptions(deletedMods...).Count(ctx, boil.GetContextDB())
+		if err != nil {
+			c.Error(response.Internal(err))
+			return
+		}
+		pagination.Total = &total
+	}
+
+	mods := []qm.QueryMod{
+		qm.Select("subscriptions.*"),
+		qm.InnerJoin("services on services.id = subscriptions.service_id"),
+		qm.Load(models.SubscriptionRels.Service),
+		qm.Load(models.SubscriptionRels.SubscriptionPrices),
+		qm.OrderBy(orderBy),
+		qm.Limit(listRequest.Limit + 1),
+	}
+
+	mods = append(mods, deletedMods...)
+
+	// Offset mode is used when offset is given or custom sort is requested, keyset mode otherwise
+	offsetMode := listRequest.Offset != nil || listRequest.Sort != nil
+
+	if offsetMode {
+		offset := 0
+		if listRequest.Offset != nil {
+			offset = *listRequest.Offset
+		}
+		pagination.Offset = &offset
+		mods = append(mods, qm.Offset(offset))
+	} else if listRequest.Cursor != nil {
+		cursor, err := request.DecodeCursor(*listRequest.Cursor)
+		if err != nil {
+			c.Error(response.InvalidField("cursor", "cursor", "malformed cursor"))
+			return
+		}
+		mods = append(mods, qm.Where(
+			"(subscriptions.created_at, subscriptions.id) > (?, ?)", cursor.CreatedAt, cursor.ID,
+		))
+	}
+
+	page, err := models.Subscriptions(mods...).All(ctx, boil.GetContextDB())
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(response.Internal(err))
 		return
 	}
 
+	if len(page) > listRequest.Limit {
+		page = page[:listRequest.Limit]
+
+		if offsetMode {
+			nextOffset := *pagination.Offset + listRequest.Limit
+			pagination.NextOffset = &nextOffset
+		} else {
+			last := page[len(page)-1]
+			nextCursor := request.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode()
+			pagination.NextCursor = &nextCursor
+		}
+	}
+
+	subscriptions := make([]subscription_response.UserSubscription, 0, len(page))
+
+	for _, subscription := range page {
+		subscriptions = append(subscriptions, subscription_response.NewUserSubscription(subscription, subscription.R.Service))
+	}
+
+	c.Set("meta", map[string]interface{}{
+		"pagination": pagination,
+	})
+
 	c.Set("data", map[string]interface{}{
 		"subscriptions": subscriptions,
 	})
 }
 
+// Build ORDER BY clause from sort parameter like "price,-start_date"
+func getSubscriptionsOrder(sort *string) (string, error) {
+
+	if sort == nil {
+		return "subscriptions.created_at, subscriptions.id", nil
+	}
+
+	var order []string
+
+	for _, field := range strings.Split(*sort, ",") {
+		direction := "ASC"
+		if strings.HasPrefix(field, "-") {
+			direction = "DESC"
+			field = field[1:]
+		}
+
+		column, ok := sortableSubscriptionColumns[field]
+		if !ok {
+			return "", fmt.Errorf("unknown sort field %q", field)
+		}
+
+		order = append(order, column+" "+direction)
+	}
+
+	return strings.Join(append(order, "subscriptions.id"), ", "), nil
+}
+
 // Subscribe user
 func (ctrl *SubscriptionController) CreateSubscription(c *gin.Context) {
 
 	var request subscription_request.CreateRequest
 
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(response.Validation(err))
 		return
 	}
 
-	user, err := models.Users(qm.Where("uuid=?", request.UserUUID)).One(c.Request.Context(), boil.GetContextDB())
+	service, err := findService(c.Request.Context(), request.ServiceID, request.ServiceName)
 
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.Error(response.Database(err, "service not found"))
 		return
 	}
 
 	subscription := models.Subscription{
-		UserID:      user.ID,
-		ServiceName: request.ServiceName,
-		Price:       request.Price,
+		UserID:    request.UserUUID,
+		ServiceID: service.ID,
+	}
+
+	subscription.StartDate, _ = time.Parse(response.MonthLayout, request.StartDate)
+
+	price := models.SubscriptionPrice{
+		Price:         request.Price,
+		Currency:      request.Currency,
+		EffectiveFrom: subscription.StartDate,
+	}
+
+	if price.Currency == "" {
+		price.Currency = currency.Default
+	}
+
+	if request.EndDate != nil {
+		endDate, _ := time.Parse(response.MonthLayout, *request.EndDate)
+		subscription.EndDate = null.TimeFrom(endDate)
 	}
 
-	err = subscription.Insert(c.Request.Context(), boil.GetContextDB(), boil.Infer())
+	clash, err := findOverlappingSubscription(c.Request.Context(), boil.GetContextDB(), &subscription)
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(response.Internal(err))
 		return
 	}
 
-	userSubscription := subscription_response.UserSubscription{
-		ServiceName: subscription.ServiceName,
-		Price:       subscription.Price,
-		UserUUID:    user.UUID,
-		StartDate:   subscription.StartDate,
+	if clash != nil {
+		c.Error(response.Conflict(getOverlapMessage(clash)))
+		return
+	}
+
+	err = database.Transaction(c.Request.Context(), func(exec boil.ContextExecutor) error {
+
+		if err := provision.Users(c.Request.Context(), exec, subscription.UserID); err != nil {
+			return err
+		}
+
+		if err := subscription.Insert(c.Request.Context(), exec, boil.Infer()); err != nil {
+			return err
+		}
+
+		if err := subscription.AddSubscriptionPrices(c.Request.Context(), exec, true, &price); err != nil {
+			return err
+		}
+
+		after := audit.Snapshot(&subscription, subscription.R.SubscriptionPrices)
+
+		return audit.Record(c.Request.Context(), exec, &subscription, audit.OperationInsert, nil, after)
+	})
+
+	if err != nil {
+		c.Error(getSubscriptionWriteError(c.Request.Context(), err, &subscription, "service not found"))
+		return
 	}
 
+	c.Header("Location", "/subscriptions/"+subscription.UUID)
+	c.Header("ETag", getSubscriptionETag(&subscription))
+	c.Status(http.StatusCreated)
+
 	c.Set("data", map[string]interface{}{
-		"subscription": userSubscription,
+		"subscription": subscription_response.NewUserSubscription(&subscription, service),
+	})
+}
+
+// Import subscriptions from CSV or JSON array. Any invalid row rejects the whole import unless
+// best effort mode is asked for, in which case valid rows are imported and invalid ones are reported
+func (ctrl *SubscriptionController) ImportSubscriptions(c *gin.Context) {
+
+	var request subscription_request.ImportRequest
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	rows, apiError := readImportRows(c)
+
+	if apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	validateImportRows(rows)
+
+	if err := resolveImportRows(ctx, boil.GetContextDB(), rows); err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	if err := checkImportOverlaps(ctx, boil.GetContextDB(), rows); err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	imported, apiError := importRows(ctx, rows, request.Mode)
+
+	if apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	if len(imported) > 0 {
+		c.Status(http.StatusCreated)
+	}
+
+	c.Set("data", map[string]interface{}{
+		"imported":      len(imported),
+		"failed":        len(rows) - len(imported),
+		"subscriptions": imported,
+		"errors":        getImportErrors(rows),
 	})
 }
 
 // Get user's subscription info
 func (ctrl *SubscriptionController) ReadSubscription(c *gin.Context) {
 
-	var request request.IdRequest
+	var deletedRequest request.WithDeletedRequest
+
+	if err := c.ShouldBindQuery(&deletedRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
 
-	if err := c.ShouldBindUri(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if apiError := authorizeWithDeleted(c, deletedRequest.WithDeleted); apiError != nil {
+		c.Error(apiError)
 		return
 	}
 
-	var subscription subscription_response.UserSubscription
+	subscriptionID, apiError := ctrl.bindSubscriptionID(c)
+
+	if apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	mods := []qm.QueryMod{
+		subscriptionID,
+		qm.Load(models.SubscriptionRels.Service),
+		qm.Load(models.SubscriptionRels.SubscriptionPrices),
+	}
+
+	if deletedRequest.WithDeleted {
+		mods = append(mods, qm.WithDeleted())
+	}
 
-	err := models.NewQuery(
-		qm.Select("service_name", "price", "uuid", "start_date"),
-		qm.From("subscriptions"),
-		qm.InnerJoin("users on subscriptions.user_id = users.id"),
-		qm.Where("subscriptions.id = ?", request.ID),
-	).Bind(context.Background(), boil.GetContextDB(), &subscription)
+	subscription, err := models.Subscriptions(mods...).One(c.Request.Context(), boil.GetContextDB())
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(response.Database(err, "subscription not found"))
+		return
+	}
+
+	if notModified(c, subscription) {
+		c.Status(http.StatusNotModified)
 		return
 	}
 
 	c.Set("data", map[string]interface{}{
-		"subscription": subscription,
+		"subscription": subscription_response.NewUserSubscription(subscription, subscription.R.Service),
 	})
 }
 
-// Update user's subscription details
+// Update user's subscription details: PUT replaces the whole record, PATCH applies JSON merge patch
 func (ctrl *SubscriptionController) UpdateSubscription(c *gin.Context) {
 
-	c.AbortWithStatus(http.StatusMethodNotAllowed)
+	if c.Request.Method == http.MethodPatch && c.ContentType() != request.MergePatchContentType {
+		c.Error(response.UnsupportedMediaType("content type must be " + request.MergePatchContentType))
+		return
+	}
+
+	subscriptionID, apiError := ctrl.bindSubscriptionID(c)
+
+	if apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	subscription, err := models.Subscriptions(subscriptionID).One(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Database(err, "subscription not found"))
+		return
+	}
+
+	if apiError := ctrl.checkIfMatch(c, subscription); apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	service, err := subscription.Service().One(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	if err := loadSubscriptionPrices(ctx, boil.GetContextDB(), subscription); err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	before := audit.Snapshot(subscription, subscription.R.SubscriptionPrices)
+
+	var updateRequest subscription_request.UpdateRequest
+
+	if c.Request.Method == http.MethodPatch {
+		updateRequest, err = getPatchedUpdateRequest(c, subscription)
+	} else {
+		err = c.ShouldBindJSON(&updateRequest)
+	}
+
+	if err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	if updateRequest.ServiceName != nil || *updateRequest.ServiceID != service.ID {
+		service, err = findService(ctx, updateRequest.ServiceID, updateRequest.ServiceName)
+
+		if err != nil {
+			c.Error(response.Database(err, "service not found"))
+			return
+		}
+	}
+
+	// User seen for the first time is provisioned along with the change
+	userChanged := updateRequest.UserUUID != subscription.UserID
+
+	subscription.UserID = updateRequest.UserUUID
+	subscription.ServiceID = service.ID
+	subscription.StartDate, _ = time.Parse(response.MonthLayout, updateRequest.StartDate)
+	subscription.EndDate = null.Time{}
+
+	if updateRequest.EndDate != nil {
+		endDate, _ := time.Parse(response.MonthLayout, *updateRequest.EndDate)
+		subscription.EndDate = null.TimeFrom(endDate)
+	}
+
+	clash, err := findOverlappingSubscription(ctx, boil.GetContextDB(), subscription)
+
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	if clash != nil {
+		c.Error(response.Conflict(getOverlapMessage(clash)))
+		return
+	}
+
+	// Price change is appended to the history, by default it takes effect from the current month
+	priceEffectiveFrom := truncateToMonth(time.Now())
+
+	if updateRequest.PriceEffectiveFrom != nil {
+		priceEffectiveFrom, _ = time.Parse(response.MonthLayout, *updateRequest.PriceEffectiveFrom)
+	} else if priceEffectiveFrom.Before(subscription.StartDate) {
+		priceEffectiveFrom = subscription.StartDate
+	}
+
+	price := models.SubscriptionPrice{
+		SubscriptionID: subscription.ID,
+		Price:          updateRequest.Price,
+		Currency:       updateRequest.Currency,
+		EffectiveFrom:  priceEffectiveFrom,
+	}
+
+	if price.Currency == "" {
+		price.Currency = currency.Default
+	}
+
+	err = database.Transaction(ctx, func(exec boil.ContextExecutor) error {
+
+		if err := incrementSubscriptionVersion(ctx, exec, subscription); err != nil {
+			return getSubscriptionVersionError(c, err)
+		}
+
+		if userChanged {
+			if err := provision.Users(ctx, exec, subscription.UserID); err != nil {
+				return err
+			}
+		}
+
+		if _, err := subscription.Update(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+
+		// Price already in effect from that month is not repeated in the history
+		effective := pricing.EntryAt(subscription.R.SubscriptionPrices, priceEffectiveFrom)
+		if effective == nil || effective.Price != price.Price || effective.Currency != price.Currency {
+			err := price.Upsert(ctx, exec, true,
+				[]string{models.SubscriptionPriceColumns.SubscriptionID, models.SubscriptionPriceColumns.EffectiveFrom},
+				boil.Whitelist(models.SubscriptionPriceColumns.Price, models.SubscriptionPriceColumns.Currency),
+				boil.Infer(),
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := loadSubscriptionPrices(ctx, exec, subscription); err != nil {
+				return err
+			}
+		}
+
+		after := audit.Snapshot(subscription, subscription.R.SubscriptionPrices)
+
+		return audit.Record(ctx, exec, subscription, audit.OperationUpdate, before, after)
+	})
+
+	if err != nil {
+		c.Error(getSubscriptionWriteError(ctx, err, subscription, "subscription not found"))
+		return
+	}
+
+	c.Header("ETag", getSubscriptionETag(subscription))
+
+	c.Set("data", map[string]interface{}{
+		"subscription": subscription_response.NewUserSubscription(subscription, service),
+	})
 }
 
-// Cancel subscription
+// Build update request from the current subscription state patched with request body
+func getPatchedUpdateRequest(c *gin.Context, subscription *models.Subscription) (subscription_request.UpdateRequest, error) {
+
+	price := pricing.Current(subscription.R.SubscriptionPrices)
+
+	updateRequest := subscription_request.UpdateRequest{
+		UserUUID:  subscription.UserID,
+		ServiceID: &subscription.ServiceID,
+		Price:     price.Price,
+		Currency:  price.Currency,
+		StartDate: subscription.StartDate.Format(response.MonthLayout),
+	}
+
+	if subscription.EndDate.Valid {
+		endDate := subscription.EndDate.Time.Format(response.MonthLayout)
+		updateRequest.EndDate = &endDate
+	}
+
+	document, err := json.Marshal(updateRequest)
+	if err != nil {
+		return updateRequest, err
+	}
+
+	patch, err := c.GetRawData()
+	if err != nil {
+		return updateRequest, err
+	}
+
+	// Service given by name replaces the current service reference
+	var patchFields map[string]json.RawMessage
+	if json.Unmarshal(patch, &patchFields) == nil {
+		_, hasServiceID := patchFields["service_id"]
+		if _, hasServiceName := patchFields["service_name"]; hasServiceName && !hasServiceID {
+			patchFields["service_id"] = json.RawMessage("null")
+			if patch, err = json.Marshal(patchFields); err != nil {
+				return updateRequest, err
+			}
+		}
+	}
+
+	document, err = request.MergePatch(document, patch)
+	if err != nil {
+		return updateRequest, err
+	}
+
+	updateRequest = subscription_request.UpdateRequest{}
+
+	if err := json.Unmarshal(document, &updateRequest); err != nil {
+		return updateRequest, err
+	}
+
+	return updateRequest, binding.Validator.ValidateStruct(&updateRequest)
+}
+
+// Find another subscription of the same user to the same service active in any month of the subscription period
+func findOverlappingSubscription(ctx context.Context, exec boil.ContextExecutor, subscription *models.Subscription) (*models.Subscription, error) {
+
+	clash, err := models.Subscriptions(
+		models.SubscriptionWhere.ID.NEQ(subscription.ID),
+		models.SubscriptionWhere.UserID.EQ(subscription.UserID),
+		models.SubscriptionWhere.ServiceID.EQ(subscription.ServiceID),
+		qm.Where(subscriptionPeriodSQL+` && tsrange(
+			date_trunc('month', ?::timestamptz AT TIME ZONE 'UTC'),
+			date_trunc('month', ?::timestamptz AT TIME ZONE 'UTC'),
+			'[]'
+		)`, subscription.StartDate, subscription.EndDate),
+		qm.OrderBy(models.SubscriptionColumns.StartDate),
+	).One(ctx, exec)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return clash, err
+}
+
+// Describe the subscription whose period is overlapped
+func getOverlapMessage(clash *models.Subscription) string {
+
+	period := clash.StartDate.Format(response.MonthLayout) + " - "
+	if clash.EndDate.Valid {
+		period += clash.EndDate.Time.Format(response.MonthLayout)
+	}
+
+	return fmt.Sprintf("subscription period overlaps subscription %s (%s) of the same user to the same service", clash.UUID, period)
+}
+
+// Convert subscription write error. Period conflict raised by the database for concurrent requests names the clashing subscription
+func getSubscriptionWriteError(ctx context.Context, err error, subscription *models.Subscription, notFoundMessage string) *response.Error {
+
+	apiError := response.Database(err, notFoundMessage)
+
+	// Integrity violations only, conflicts detected by the handler are described already
+	if apiError.Code == response.CodeConflict && apiError.Cause != nil {
+		if clash, _ := findOverlappingSubscription(ctx, boil.GetContextDB(), subscription); clash != nil {
+			apiError.Message = getOverlapMessage(clash)
+		}
+	}
+
+	return apiError
+}
+
+// Load subscription price history ordered by effective month
+func loadSubscriptionPrices(ctx context.Context, exec boil.ContextExecutor, subscription *models.Subscription) error {
+
+	prices, err := subscription.SubscriptionPrices(
+		qm.OrderBy(models.SubscriptionPriceColumns.EffectiveFrom),
+	).All(ctx, exec)
+
+	if err != nil {
+		return err
+	}
+
+	if subscription.R == nil {
+		subscription.R = subscription.R.NewStruct()
+	}
+	subscription.R.SubscriptionPrices = prices
+
+	return nil
+}
+
+// Get subscription price timeline including scheduled changes
+func (ctrl *SubscriptionController) GetSubscriptionPrices(c *gin.Context) {
+
+	subscriptionID, apiError := ctrl.bindSubscriptionID(c)
+
+	if apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	subscription, err := models.Subscriptions(subscriptionID).One(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Database(err, "subscription not found"))
+		return
+	}
+
+	if err := loadSubscriptionPrices(ctx, boil.GetContextDB(), subscription); err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	prices := subscription.R.SubscriptionPrices
+
+	c.Set("data", map[string]interface{}{
+		"prices": subscription_response.NewPrices(prices, pricing.EntryAt(prices, time.Now())),
+	})
+}
+
+// Get audit trail of subscription changes, deleted subscriptions included
+func (ctrl *SubscriptionController) GetSubscriptionHistory(c *gin.Context) {
+
+	subscriptionID, apiError := ctrl.bindSubscriptionID(c)
+
+	if apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	var historyRequest subscription_request.HistoryRequest
+
+	if err := c.ShouldBindQuery(&historyRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	if historyRequest.Limit == 0 {
+		historyRequest.Limit = defaultPageLimit
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	subscription, err := models.Subscriptions(
+		qm.Select(models.SubscriptionColumns.ID),
+		subscriptionID,
+		qm.WithDeleted(),
+	).One(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Database(err, "subscription not found"))
+		return
+	}
+
+	mods := []qm.QueryMod{
+		models.SubscriptionAuditWhere.SubscriptionID.EQ(null.IntFrom(subscription.ID)),
+		qm.OrderBy("subscription_audits.created_at, subscription_audits.id"),
+		qm.Limit(historyRequest.Limit + 1),
+	}
+
+	if historyRequest.Cursor != nil {
+		cursor, err := request.DecodeCursor(*historyRequest.Cursor)
+		if err != nil {
+			c.Error(response.InvalidField("cursor", "cursor", "malformed cursor"))
+			return
+		}
+		mods = append(mods, qm.Where(
+			"(subscription_audits.created_at, subscription_audits.id) > (?, ?)", cursor.CreatedAt, cursor.ID,
+		))
+	}
+
+	entries, err := models.SubscriptionAudits(mods...).All(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	pagination := response.Pagination{
+		Limit: historyRequest.Limit,
+	}
+
+	if len(entries) > historyRequest.Limit {
+		entries = entries[:historyRequest.Limit]
+		last := entries[len(entries)-1]
+		nextCursor := request.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode()
+		pagination.NextCursor = &nextCursor
+	}
+
+	c.Set("meta", map[string]interface{}{
+		"pagination": pagination,
+	})
+
+	c.Set("data", map[string]interface{}{
+		"history": subscription_response.NewHistory(entries),
+	})
+}
+
+// Cancel subscription. It is soft deleted and can be restored until purged
 func (ctrl *SubscriptionController) DeleteSubscription(c *gin.Context) {
 
-	var request request.IdRequest
+	subscriptionID, apiError := ctrl.bindSubscriptionID(c)
 
-	if err := c.ShouldBindUri(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if apiError != nil {
+		c.Error(apiError)
 		return
 	}
 
-	_, err := models.Subscriptions(models.SubscriptionWhere.ID.EQ(request.ID)).
-		DeleteAll(context.Background(), boil.GetContextDB())
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	err := database.Transaction(ctx, func(exec boil.ContextExecutor) error {
+
+		// Row is locked so that concurrent deletion waits and then finds it deleted
+		subscription, err := models.Subscriptions(
+			subscriptionID,
+			qm.Load(models.SubscriptionRels.SubscriptionPrices),
+			qm.For("UPDATE"),
+		).One(ctx, exec)
+
+		if err != nil {
+			return err
+		}
+
+		if apiError := ctrl.checkIfMatch(c, subscription); apiError != nil {
+			return apiError
+		}
+
+		if err := incrementSubscriptionVersion(ctx, exec, subscription); err != nil {
+			return err
+		}
+
+		before := audit.Snapshot(subscription, subscription.R.SubscriptionPrices)
+
+		if _, err := subscription.Delete(ctx, exec, false); err != nil {
+			return err
+		}
+
+		after := audit.Snapshot(subscription, subscription.R.SubscriptionPrices)
+
+		return audit.Record(ctx, exec, subscription, audit.OperationDelete, before, after)
+	})
 
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(response.Database(err, "subscription not found"))
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
+// Restore soft deleted subscription unless its period is taken by another subscription meanwhile
+func (ctrl *SubscriptionController) RestoreSubscription(c *gin.Context) {
+
+	subscriptionID, apiError := ctrl.bindSubscriptionID(c)
+
+	if apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	subscription, err := models.Subscriptions(
+		subscriptionID,
+		qm.Load(models.SubscriptionRels.Service),
+		qm.Load(models.SubscriptionRels.SubscriptionPrices),
+		qm.WithDeleted(),
+	).One(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Database(err, "subscription not found"))
+		return
+	}
+
+	if !subscription.DeletedAt.Valid {
+		c.Error(response.Conflict("subscription is not deleted"))
+		return
+	}
+
+	clash, err := findOverlappingSubscription(ctx, boil.GetContextDB(), subscription)
+
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	if clash != nil {
+		c.Error(response.Conflict(getOverlapMessage(clash)))
+		return
+	}
+
+	before := audit.Snapshot(subscription, subscription.R.SubscriptionPrices)
+
+	subscription.DeletedAt = null.Time{}
+
+	err = database.Transaction(ctx, func(exec boil.ContextExecutor) error {
+
+		if err := incrementSubscriptionVersion(ctx, exec, subscription); err != nil {
+			return getSubscriptionVersionError(c, err)
+		}
+
+		if _, err := subscription.Update(ctx, exec, boil.Whitelist(models.SubscriptionColumns.DeletedAt)); err != nil {
+			return err
+		}
+
+		after := audit.Snapshot(subscription, subscription.R.SubscriptionPrices)
+
+		return audit.Record(ctx, exec, subscription, audit.OperationRestore, before, after)
+	})
+
+	if err != nil {
+		c.Error(getSubscriptionWriteError(ctx, err, subscription, "subscription not found"))
+		return
+	}
+
+	c.Header("ETag", getSubscriptionETag(subscription))
+
+	c.Set("data", map[string]interface{}{
+		"subscription": subscription_response.NewUserSubscription(subscription, subscription.R.Service),
+	})
+}
+
 // Get accounting report
 func (ctrl *SubscriptionController) GetAccountingReport(c *gin.Context) {
-	boil.DebugMode = true
-	boil.DebugWriter = os.Stdout // или твой логгер
+
+	var deletedRequest request.WithDeletedRequest
+
+	if err := c.ShouldBindQuery(&deletedRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	if apiError := authorizeWithDeleted(c, deletedRequest.WithDeleted); apiError != nil {
+		c.Error(apiError)
+		return
+	}
 
 	var request subscription_request.ReportRequest
 
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(response.Validation(err))
 		return
 	}
 
-	mods, err := getGetAccountingReportCriteria(request, c)
+	request.WithDeleted = deletedRequest.WithDeleted
+
+	from, to, err := getAccountingReportPeriod(request)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(response.Validation(err))
 		return
 	}
 
-	mods = append(mods, qm.Select("COUNT(*) as count, COALESCE(SUM(price), 0) as sum"))
+	mods := getAccountingReportCriteria(request, from, to)
+	mods = append(mods,
+		qm.Select("subscriptions.id as internal_id", "subscriptions.uuid as id", "service_id", "services.name as service_name", "user_id as uuid", "start_date", "end_date"),
+		qm.InnerJoin("services on subscriptions.service_id = services.id"),
+		qm.OrderBy("subscriptions.id"),
+	)
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
-	var result struct {
-		Count int `boil:"count"`
-		Sum   int `boil:"sum"`
+	var subscriptions []subscription_response.ReportSubscription
+
+	err = models.Subscriptions(mods...).Bind(ctx, boil.GetContextDB(), &subscriptions)
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
 	}
 
-	err = models.Subscriptions(mods...).Bind(ctx, boil.GetContextDB(), &result)
+	prices, err := getSubscriptionsPrices(ctx, subscriptions)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(response.Internal(err))
 		return
 	}
 
-	c.Set("data", map[string]interface{}{
-		"sum":   result.Sum,
-		"count": result.Count,
-		"from":  request.From,
-		"to":    request.To,
-	})
+	reportCurrency := currency.Default
+	if request.Currency != nil {
+		reportCurrency = *request.Currency
+	}
+
+	billed := make([]subscription_response.ReportSubscription, 0, len(subscriptions))
+	periods := make([][2]time.Time, 0, len(subscriptions))
+	earliest := to
+
+	for _, subscription := range subscriptions {
+		first, last, ok := getBilledPeriod(subscription.StartDate, subscription.EndDate, from, to)
+		if !ok {
+			continue
+		}
+		if first.Before(earliest) {
+			earliest = first
+		}
+		billed = append(billed, subscription)
+		periods = append(periods, [2]time.Time{first, last})
+	}
+
+	rates, err := currency.LoadRates(ctx, boil.GetContextDB(), earliest, to)
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	sum := null.Int64From(0)
+	totals := newReportTotals(reportCurrency, rates)
+	rows := newReportRows(request.GroupBy)
+
+	for i := range billed {
+		subscription := &billed[i]
+		subscription.Sum = null.Int64From(0)
+		// Each month is charged with the price in effect in that month converted at that month rate
+		for month := periods[i][0]; !month.After(periods[i][1]); month = month.AddDate(0, 1, 0) {
+			charge := totals.charge(pricing.EntryAt(prices[subscription.InternalID], month), month)
+			subscription.Months++
+			addCharge(&subscription.Sum, charge)
+			rows.add(subscription, month, charge)
+		}
+		addCharge(&sum, subscription.Sum)
+	}
+
+	data := map[string]interface{}{
+		"sum":           sum,
+		"currency":      reportCurrency,
+		"count":         len(billed),
+		"from":          request.From,
+		"to":            request.To,
+		"subscriptions": billed,
+		"subtotals":     totals.getSubtotals(),
+		"missing_rates": totals.getMissingRates(),
+	}
+
+	if len(request.GroupBy) > 0 {
+		// Zero rows start from the beginning of the period, or from the first billed month when it is open
+		first := earliest
+		if from != nil {
+			first = *from
+		}
+		data["rows"] = rows.getRows(first, to)
+	}
+
+	c.Set("data", data)
 }
 
-func getGetAccountingReportCriteria(request subscription_request.ReportRequest, c *gin.Context) ([]qm.QueryMod, error) {
+// Stream subscriptions matching the report filters as CSV or NDJSON, bypassing the response envelope
+func (ctrl *SubscriptionController) ExportSubscriptions(c *gin.Context) {
+
+	var request subscription_request.ExportRequest
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	if apiError := authorizeWithDeleted(c, request.WithDeleted); apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	reportRequest := request.ReportRequest()
 
-	var mods []qm.QueryMod
+	from, to, err := getAccountingReportPeriod(reportRequest)
+	if err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	format := "csv"
+	if request.Format != nil {
+		format = *request.Format
+	} else if strings.Contains(c.GetHeader("Accept"), ndjsonContentType) {
+		format = "ndjson"
+	}
+
+	mods := getAccountingReportCriteria(reportRequest, from, to)
+	mods = append(mods,
+		qm.Select(
+			"subscriptions.uuid",
+			"subscriptions.user_id",
+			"subscriptions.service_id",
+			"services.name",
+			"COALESCE(current_price.price, 0)",
+			"COALESCE(current_price.currency, '')",
+			"subscriptions.start_date",
+			"subscriptions.end_date",
+			"subscriptions.created_at",
+			"subscriptions.deleted_at",
+		),
+		qm.InnerJoin("services on subscriptions.service_id = services.id"),
+		qm.LeftOuterJoin(currentPriceJoinSQL),
+		qm.OrderBy("subscriptions.id"),
+	)
+
+	var writer *subscriptionExportWriter
+	if format == "ndjson" {
+		writer = newNDJSONExportWriter(c.Writer)
+	} else {
+		writer = newCSVExportWriter(c.Writer)
+	}
+
+	// Rows are read from the cursor one by one while they are written, export is not limited in time
+	writer.liftDeadline()
+
+	rows, err := models.Subscriptions(mods...).QueryContext(c.Request.Context(), boil.GetContextDB())
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+	defer rows.Close()
+
+	c.Header("Content-Type", writer.contentType)
+	c.Header("Content-Disposition", `attachment; filename="subscriptions.`+format+`"`)
+	c.Header("Trailer", exportStatusTrailer+", "+exportRowsTrailer)
+	c.Status(http.StatusOK)
+
+	count := 0
+	err = writer.writeHeader()
+
+	for err == nil && rows.Next() {
+		var subscription subscription_response.ExportSubscription
+		if subscription, err = subscription_response.ScanExportSubscription(rows); err == nil {
+			err = writer.write(subscription)
+		}
+		if err == nil {
+			count++
+			if count%exportFlushRows == 0 {
+				err = writer.flush()
+			}
+		}
+	}
+
+	if err == nil {
+		err = rows.Err()
+	}
+
+	// Status is sent already, the client learns about the failure from the trailers
+	if err := writer.finish(count, err); err != nil {
+		c.Error(response.Internal(err))
+	}
+}
+
+// Get report period bounds as months. Lower bound is optional, upper bound defaults to the current month:
+// subscriptions without end date are billed up to now, and the ones starting later are left out
+func getAccountingReportPeriod(request subscription_request.ReportRequest) (*time.Time, time.Time, error) {
+
+	var from *time.Time
+	to := truncateToMonth(time.Now())
 
 	if request.From != nil {
-		if fromDate, err := time.Parse("02-01-2006", *request.From); err != nil {
-			return nil, err
-		} else {
-			mods = append(mods, models.SubscriptionWhere.StartDate.GTE(fromDate))
+		fromDate, err := time.Parse("02-01-2006", *request.From)
+		if err != nil {
+			return nil, to, err
 		}
+		fromDate = truncateToMonth(fromDate)
+		from = &fromDate
 	}
 
 	if request.To != nil {
-		if toDate, err := time.Parse("02-01-2006", *request.To); err != nil {
-			return nil, err
-		} else {
-			mods = append(mods, models.SubscriptionWhere.EndDate.LTE(null.TimeFrom(toDate)))
+		toDate, err := time.Parse("02-01-2006", *request.To)
+		if err != nil {
+			return nil, to, err
 		}
+		to = truncateToMonth(toDate)
+	}
+
+	return from, to, nil
+}
+
+// Select subscriptions overlapping the report period
+func getAccountingReportCriteria(request subscription_request.ReportRequest, from *time.Time, to time.Time) []qm.QueryMod {
+
+	mods := []qm.QueryMod{
+		models.SubscriptionWhere.StartDate.LT(to.AddDate(0, 1, 0)),
+	}
+
+	if from != nil {
+		mods = append(mods, qm.Expr(
+			models.SubscriptionWhere.EndDate.IsNull(),
+			qm.Or2(models.SubscriptionWhere.EndDate.GTE(null.TimeFrom(*from))),
+		))
 	}
 
 	if request.UserUUID != nil {
-		mods = append(mods, models.UserWhere.UUID.EQ(*request.UserUUID), qm.InnerJoin("users on subscriptions.user_id = users.id"))
+		mods = append(mods, models.SubscriptionWhere.UserID.EQ(*request.UserUUID))
+	}
+
+	if request.ServiceID != nil {
+		mods = append(mods, models.SubscriptionWhere.ServiceID.EQ(*request.ServiceID))
 	}
 
 	if request.ServiceName != nil {
-		mods = append(mods, models.SubscriptionWhere.ServiceName.EQ(*request.ServiceName))
+		mods = append(mods, models.ServiceWhere.Name.EQ(*request.ServiceName))
+	}
+
+	if request.WithDeleted {
+		mods = append(mods, qm.WithDeleted())
 	}
 
-	return mods, nil
+	return mods
+}
+
+// Get price histories of the report subscriptions grouped by subscription
+func getSubscriptionsPrices(ctx context.Context, subscriptions []subscription_response.ReportSubscription) (map[int]models.SubscriptionPriceSlice, error) {
+
+	ids := make([]int, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		ids = append(ids, subscription.InternalID)
+	}
+
+	prices, err := models.SubscriptionPrices(
+		models.SubscriptionPriceWhere.SubscriptionID.IN(ids),
+	).All(ctx, boil.GetContextDB())
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]models.SubscriptionPriceSlice, len(subscriptions))
+	for _, price := range prices {
+		result[price.SubscriptionID] = append(result[price.SubscriptionID], price)
+	}
+
+	return result, nil
+}
+
+// Get the first and the last calendar months in which subscription is active within the report period.
+// Both start and end months of subscription are billed, subscription without end date is ongoing
+func getBilledPeriod(startDate time.Time, endDate null.Time, from *time.Time, to time.Time) (time.Time, time.Time, bool) {
+
+	first := truncateToMonth(startDate)
+	if from != nil && from.After(first) {
+		first = *from
+	}
+
+	last := to
+	if endDate.Valid && truncateToMonth(endDate.Time).Before(last) {
+		last = truncateToMonth(endDate.Time)
+	}
+
+	return first, last, !first.After(last)
+}
+
+// Get the first moment of the date's month
+func truncateToMonth(date time.Time) time.Time {
+
+	date = date.UTC()
+
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
 }
@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/http/response"
+	subscription_response "github.com/zeleniy/test28/internal/http/response/subscription"
+)
+
+const ndjsonContentType = "application/x-ndjson"
+
+// Number of rows written between flushes of the export to the client
+const exportFlushRows = 100
+
+// Time the client has to read the next chunk of the export
+const exportWriteTimeout = 30 * time.Second
+
+// Trailers sent after the export body. Export without them, or with the failed status, is truncated
+const (
+	exportStatusTrailer = "Export-Status"
+	exportRowsTrailer   = "Export-Rows"
+)
+
+// Export statuses
+const (
+	exportStatusComplete = "complete"
+	exportStatusFailed   = "failed"
+)
+
+// Export rows encoder writing straight to the response
+type subscriptionExportWriter struct {
+	contentType string
+	writer      gin.ResponseWriter
+	controller  *http.ResponseController
+	header      func() error
+	encode      func(subscription_response.ExportSubscription) error
+	// Terminal record telling the client the export is incomplete, for the formats which can have one
+	abort  func() error
+	commit func() error
+}
+
+func newCSVExportWriter(writer gin.ResponseWriter) *subscriptionExportWriter {
+
+	csvWriter := csv.NewWriter(writer)
+
+	return &subscriptionExportWriter{
+		contentType: "text/csv; charset=utf-8",
+		writer:      writer,
+		controller:  http.NewResponseController(writer),
+		header: func() error {
+			return csvWriter.Write(subscription_response.ExportCSVHeader)
+		},
+		encode: func(subscription subscription_response.ExportSubscription) error {
+			return csvWriter.Write(subscription.CSVRecord())
+		},
+		abort: func() error {
+			return nil
+		},
+		commit: func() error {
+			csvWriter.Flush()
+			return csvWriter.Error()
+		},
+	}
+}
+
+func newNDJSONExportWriter(writer gin.ResponseWriter) *subscriptionExportWriter {
+
+	encoder := json.NewEncoder(writer)
+
+	return &subscriptionExportWriter{
+		contentType: ndjsonContentType,
+		writer:      writer,
+		controller:  http.NewResponseController(writer),
+		header: func() error {
+			return nil
+		},
+		encode: func(subscription subscription_response.ExportSubscription) error {
+			return encoder.Encode(subscription)
+		},
+		abort: func() error {
+			return encoder.Encode(map[string]interface{}{
+				"error": response.Internal(nil),
+			})
+		},
+		commit: func() error {
+			return nil
+		},
+	}
+}
+
+// Lift the server write timeout, the export takes as long as it takes while the client keeps reading
+func (w *subscriptionExportWriter) liftDeadline() {
+
+	// Not every connection supports deadlines, server write timeout applies then
+	_ = w.controller.SetWriteDeadline(time.Time{})
+}
+
+// Write header row if the format has one
+func (w *subscriptionExportWriter) writeHeader() error {
+
+	return w.header()
+}
+
+// Write subscription row
+func (w *subscriptionExportWriter) write(subscription subscription_response.ExportSubscription) error {
+
+	return w.encode(subscription)
+}
+
+// Send the rest of the export and the trailers. Failed export also gets the terminal record if the format has one
+func (w *subscriptionExportWriter) finish(count int, err error) error {
+
+	if err == nil {
+		err = w.flush()
+	}
+
+	status := exportStatusComplete
+
+	if err != nil {
+		status = exportStatusFailed
+		if w.abort() == nil {
+			w.flush()
+		}
+	}
+
+	w.writer.Header().Set(exportStatusTrailer, status)
+	w.writer.Header().Set(exportRowsTrailer, strconv.Itoa(count))
+
+	return err
+}
+
+// Send buffered rows to the client and give it time to read the next chunk
+func (w *subscriptionExportWriter) flush() error {
+
+	if err := w.commit(); err != nil {
+		return err
+	}
+
+	w.writer.Flush()
+
+	// Not every connection supports deadlines, server write timeout applies then
+	_ = w.controller.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/http/request"
+	"github.com/zeleniy/test28/internal/http/response"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// Condition selecting the subscription from the path. It is addressed by UUID; serial ID is accepted
+// while integer IDs are enabled and is answered with Deprecation header
+func (ctrl *SubscriptionController) bindSubscriptionID(c *gin.Context) (qm.QueryMod, *response.Error) {
+
+	var publicIdRequest request.PublicIdRequest
+
+	err := c.ShouldBindUri(&publicIdRequest)
+	if err == nil {
+		return models.SubscriptionWhere.UUID.EQ(publicIdRequest.ID), nil
+	}
+
+	if !ctrl.IntegerIDs {
+		return nil, response.Validation(err)
+	}
+
+	var idRequest request.IdRequest
+
+	// Reported as the UUID failure, integer IDs are not advertised
+	if c.ShouldBindUri(&idRequest) != nil {
+		return nil, response.Validation(err)
+	}
+
+	c.Header("Deprecation", "true")
+
+	return models.SubscriptionWhere.ID.EQ(idRequest.ID), nil
+}
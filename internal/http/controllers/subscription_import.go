@@ -0,0 +1,629 @@
+package controllers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/lib/pq"
+	"github.com/zeleniy/test28/internal/audit"
+	"github.com/zeleniy/test28/internal/currency"
+	"github.com/zeleniy/test28/internal/database"
+	"github.com/zeleniy/test28/internal/http/request"
+	subscription_request "github.com/zeleniy/test28/internal/http/request/subscription"
+	"github.com/zeleniy/test28/internal/http/response"
+	subscription_response "github.com/zeleniy/test28/internal/http/response/subscription"
+	"github.com/zeleniy/test28/internal/models"
+	"github.com/zeleniy/test28/internal/provision"
+)
+
+// Maximum number of rows in one import
+const importMaxRows = 10000
+
+// Columns of the import CSV file, named in the header row in arbitrary order
+var importCSVColumns = []string{"user_id", "service_id", "service_name", "price", "currency", "start_date", "end_date"}
+
+// Import row with the subscription built from it
+type importRow struct {
+	index        int
+	request      subscription_request.CreateRequest
+	subscription models.Subscription
+	price        models.SubscriptionPrice
+	errors       []response.FieldError
+}
+
+// Record row field failure, empty field stands for the row as a whole
+func (r *importRow) fail(field, rule, message string) {
+
+	name := fmt.Sprintf("rows[%d]", r.index)
+	if field != "" {
+		name += "." + field
+	}
+
+	r.errors = append(r.errors, response.FieldError{
+		Field:   name,
+		Rule:    rule,
+		Message: message,
+	})
+}
+
+func (r *importRow) failed() bool {
+
+	return len(r.errors) > 0
+}
+
+// Read import rows from JSON array, CSV body or CSV file uploaded as the "file" form field
+func readImportRows(c *gin.Context) ([]*importRow, *response.Error) {
+
+	// Body is never read past the limit, uploaded file included
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, request.MaxBodySize)
+
+	var rows []*importRow
+	var err *response.Error
+
+	switch c.ContentType() {
+	case binding.MIMEJSON:
+		rows, err = readImportJSON(c.Request.Body)
+	case "text/csv":
+		rows, err = readImportCSV(c.Request.Body)
+	case binding.MIMEMultipartPOSTForm:
+		file, formErr := c.FormFile("file")
+		if tooLarge := getImportSizeError(formErr); tooLarge != nil {
+			return nil, tooLarge
+		}
+		if formErr != nil {
+			return nil, response.InvalidRequest("file field is missing")
+		}
+		reader, openErr := file.Open()
+		if openErr != nil {
+			return nil, response.Internal(openErr)
+		}
+		defer reader.Close()
+		rows, err = readImportCSV(reader)
+	default:
+		return nil, response.InvalidRequest("content type must be application/json, text/csv or multipart/form-data")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, response.InvalidRequest("there are no rows to import")
+	}
+
+	return rows, nil
+}
+
+// Reject the import whose body exceeds the size limit
+func getImportSizeError(err error) *response.Error {
+
+	var maxBytesError *http.MaxBytesError
+
+	if errors.As(err, &maxBytesError) {
+		return response.PayloadTooLarge(fmt.Sprintf("import is limited to %d bytes", maxBytesError.Limit))
+	}
+
+	return nil
+}
+
+// Rows past the limit are not read at all
+func getImportRowsError() *response.Error {
+
+	return response.InvalidRequest(fmt.Sprintf("import is limited to %d rows", importMaxRows))
+}
+
+// Read rows from JSON array of create requests one by one. Row which can not be decoded is reported as invalid
+func readImportJSON(reader io.Reader) ([]*importRow, *response.Error) {
+
+	decoder := json.NewDecoder(reader)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, getImportJSONError(err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, response.InvalidRequest("body must be an array of subscriptions")
+	}
+
+	var rows []*importRow
+
+	for decoder.More() {
+		if len(rows) == importMaxRows {
+			return nil, getImportRowsError()
+		}
+
+		var document json.RawMessage
+		if err := decoder.Decode(&document); err != nil {
+			return nil, getImportJSONError(err)
+		}
+
+		row := &importRow{index: len(rows)}
+		rows = append(rows, row)
+
+		if err := json.Unmarshal(document, &row.request); err != nil {
+			for _, detail := range response.Validation(err).Details {
+				row.fail(detail.Field, detail.Rule, detail.Message)
+			}
+			if !row.failed() {
+				row.fail("", "type", "must be object")
+			}
+		}
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, getImportJSONError(err)
+	}
+
+	return rows, nil
+}
+
+// Convert error of decoding the JSON body
+func getImportJSONError(err error) *response.Error {
+
+	if tooLarge := getImportSizeError(err); tooLarge != nil {
+		return tooLarge
+	}
+
+	return response.Validation(err)
+}
+
+// Read rows from CSV with a header naming the columns. Empty cells are treated as missing values
+func readImportCSV(reader io.Reader) ([]*importRow, *response.Error) {
+
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, response.InvalidRequest("file is empty")
+	}
+	if err != nil {
+		return nil, getImportCSVError(err)
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(importCSVColumns, name) {
+			return nil, response.InvalidRequest(fmt.Sprintf("column %q is unknown", name))
+		}
+		positions[name] = i
+	}
+
+	var rows []*importRow
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, getImportCSVError(err)
+		}
+
+		if len(rows) == importMaxRows {
+			return nil, getImportRowsError()
+		}
+
+		rows = append(rows, parseImportRecord(len(rows), record, positions))
+	}
+
+	return rows, nil
+}
+
+// Convert error of reading the CSV file
+func getImportCSVError(err error) *response.Error {
+
+	if tooLarge := getImportSizeError(err); tooLarge != nil {
+		return tooLarge
+	}
+
+	return response.InvalidRequest(err.Error())
+}
+
+// Build import row from CSV record
+func parseImportRecord(index int, record []string, positions map[string]int) *importRow {
+
+	row := &importRow{index: index}
+
+	field := func(name string) *string {
+		position, ok := positions[name]
+		if !ok {
+			return nil
+		}
+		value := strings.TrimSpace(record[position])
+		if value == "" {
+			return nil
+		}
+		return &value
+	}
+
+	if value := field("user_id"); value != nil {
+		row.request.UserUUID = *value
+	}
+
+	if value := field("service_id"); value != nil {
+		serviceID, err := strconv.Atoi(*value)
+		if err != nil {
+			row.fail("service_id", "type", "must be int")
+		}
+		row.request.ServiceID = &serviceID
+	}
+
+	row.request.ServiceName = field("service_name")
+
+	if value := field("price"); value != nil {
+		price, err := strconv.ParseInt(*value, 10, 64)
+		if err != nil {
+			row.fail("price", "type", "must be int64")
+		}
+		row.request.Price = price
+	}
+
+	if value := field("currency"); value != nil {
+		row.request.Currency = *value
+	}
+
+	if value := field("start_date"); value != nil {
+		row.request.StartDate = *value
+	}
+
+	row.request.EndDate = field("end_date")
+
+	return row
+}
+
+// Validate rows with the create request rules
+func validateImportRows(rows []*importRow) {
+
+	for _, row := range rows {
+		if row.failed() {
+			continue
+		}
+		if err := binding.Validator.ValidateStruct(&row.request); err != nil {
+			for _, detail := range response.Validation(err).Details {
+				row.fail(detail.Field, detail.Rule, detail.Message)
+			}
+		}
+	}
+}
+
+// Resolve services of the valid rows in batches and build their subscriptions. Users are provisioned on insert
+func resolveImportRows(ctx context.Context, exec boil.ContextExecutor, rows []*importRow) error {
+
+	var serviceNames []string
+	var serviceIDs []int
+
+	for _, row := range rows {
+		if row.failed() {
+			continue
+		}
+		if row.request.ServiceID != nil {
+			serviceIDs = append(serviceIDs, *row.request.ServiceID)
+		} else {
+			serviceNames = append(serviceNames, *row.request.ServiceName)
+		}
+	}
+
+	if len(serviceIDs) == 0 && len(serviceNames) == 0 {
+		return nil
+	}
+
+	services, err := models.Services(
+		models.ServiceWhere.ID.IN(serviceIDs),
+		qm.Or2(models.ServiceWhere.Name.IN(serviceNames)),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	servicesByID := make(map[int]*models.Service, len(services))
+	servicesByName := make(map[string]*models.Service, len(services))
+	for _, service := range services {
+		servicesByID[service.ID] = service
+		servicesByName[service.Name] = service
+	}
+
+	for _, row := range rows {
+		if row.failed() {
+			continue
+		}
+
+		var service *models.Service
+		var ok bool
+		if row.request.ServiceID != nil {
+			if service, ok = servicesByID[*row.request.ServiceID]; !ok {
+				row.fail("service_id", "exists", "service not found")
+			}
+		} else if service, ok = servicesByName[*row.request.ServiceName]; !ok {
+			row.fail("service_name", "exists", "service not found")
+		}
+
+		if row.failed() {
+			continue
+		}
+
+		row.subscription = models.Subscription{
+			UserID:    row.request.UserUUID,
+			ServiceID: service.ID,
+		}
+		row.subscription.StartDate, _ = time.Parse(response.MonthLayout, row.request.StartDate)
+
+		if row.request.EndDate != nil {
+			endDate, _ := time.Parse(response.MonthLayout, *row.request.EndDate)
+			row.subscription.EndDate = null.TimeFrom(endDate)
+		}
+
+		row.price = models.SubscriptionPrice{
+			Price:         row.request.Price,
+			Currency:      row.request.Currency,
+			EffectiveFrom: row.subscription.StartDate,
+		}
+
+		if row.price.Currency == "" {
+			row.price.Currency = currency.Default
+		}
+	}
+
+	return nil
+}
+
+// Reject rows overlapping the stored subscriptions or earlier rows of the batch of the same user to the same service
+func checkImportOverlaps(ctx context.Context, exec boil.ContextExecutor, rows []*importRow) error {
+
+	if err := checkStoredOverlaps(ctx, exec, rows); err != nil {
+		return err
+	}
+
+	checkBatchOverlaps(rows)
+
+	return nil
+}
+
+// Reject rows overlapping the stored subscriptions
+func checkStoredOverlaps(ctx context.Context, exec boil.ContextExecutor, rows []*importRow) error {
+
+	valid := getValidImportRows(rows)
+	if len(valid) == 0 {
+		return nil
+	}
+
+	indexes := make([]int64, len(valid))
+	userIDs := make([]string, len(valid))
+	serviceIDs := make([]int64, len(valid))
+	startDates := make([]time.Time, len(valid))
+	endDates := make([]null.Time, len(valid))
+
+	for i, row := range valid {
+		indexes[i] = int64(i)
+		userIDs[i] = row.subscription.UserID
+		serviceIDs[i] = int64(row.subscription.ServiceID)
+		startDates[i] = row.subscription.StartDate
+		endDates[i] = row.subscription.EndDate
+	}
+
+	clashes, err := exec.QueryContext(ctx, `SELECT DISTINCT ON (imported.row_index)
+			imported.row_index, subscriptions.uuid, subscriptions.start_date, subscriptions.end_date
+		FROM UNNEST($1::int[], $2::uuid[], $3::int[], $4::timestamptz[], $5::timestamptz[])
+			AS imported(row_index, user_id, service_id, start_date, end_date)
+		JOIN subscriptions ON subscriptions.user_id = imported.user_id
+			AND subscriptions.service_id = imported.service_id
+			AND subscriptions.deleted_at IS NULL
+			AND `+subscriptionPeriodSQL+` && tsrange(
+				date_trunc('month', imported.start_date AT TIME ZONE 'UTC'),
+				date_trunc('month', imported.end_date AT TIME ZONE 'UTC'),
+				'[]'
+			)
+		ORDER BY imported.row_index, subscriptions.start_date`,
+		pq.Array(indexes), pq.Array(userIDs), pq.Array(serviceIDs), pq.Array(startDates), pq.Array(endDates),
+	)
+	if err != nil {
+		return err
+	}
+	defer clashes.Close()
+
+	for clashes.Next() {
+		var position int
+		var clash models.Subscription
+		if err := clashes.Scan(&position, &clash.UUID, &clash.StartDate, &clash.EndDate); err != nil {
+			return err
+		}
+		valid[position].fail("start_date", "overlap", getOverlapMessage(&clash))
+	}
+
+	return clashes.Err()
+}
+
+// Reject rows overlapping earlier valid rows of the batch
+func checkBatchOverlaps(rows []*importRow) {
+
+	type pair struct {
+		userID    string
+		serviceID int
+	}
+
+	accepted := make(map[pair][]*importRow)
+
+	for _, row := range rows {
+		if row.failed() {
+			continue
+		}
+		key := pair{row.subscription.UserID, row.subscription.ServiceID}
+		for _, other := range accepted[key] {
+			if periodsOverlap(&row.subscription, &other.subscription) {
+				row.fail("start_date", "overlap", fmt.Sprintf("subscription period overlaps row %d of the same user to the same service", other.index))
+				break
+			}
+		}
+		if !row.failed() {
+			accepted[key] = append(accepted[key], row)
+		}
+	}
+}
+
+// Check whether subscriptions are active in any common month
+func periodsOverlap(a *models.Subscription, b *models.Subscription) bool {
+
+	startsBeforeEnd := func(subscription *models.Subscription, start time.Time) bool {
+		return !subscription.EndDate.Valid || !start.After(subscription.EndDate.Time)
+	}
+
+	return startsBeforeEnd(a, b.StartDate) && startsBeforeEnd(b, a.StartDate)
+}
+
+// Get rows without errors
+func getValidImportRows(rows []*importRow) []*importRow {
+
+	valid := make([]*importRow, 0, len(rows))
+	for _, row := range rows {
+		if !row.failed() {
+			valid = append(valid, row)
+		}
+	}
+
+	return valid
+}
+
+// Insert valid rows, any invalid row rejects the whole import unless best effort mode is asked for.
+// Subscription stored by a concurrent request after the overlap check makes the database reject the batch,
+// then the rows are checked again, so that the clashing ones get the same error as from the check
+func importRows(ctx context.Context, rows []*importRow, mode string) ([]subscription_response.ImportedSubscription, *response.Error) {
+
+	for {
+		valid := getValidImportRows(rows)
+		failed := len(rows) - len(valid)
+
+		if failed > 0 && mode != subscription_request.ImportModeBestEffort {
+			return nil, response.InvalidRows(fmt.Sprintf("%d of %d rows are invalid, nothing is imported", failed, len(rows)), getImportErrors(rows))
+		}
+
+		if len(valid) == 0 {
+			return make([]subscription_response.ImportedSubscription, 0), nil
+		}
+
+		var imported []subscription_response.ImportedSubscription
+
+		err := database.Transaction(ctx, func(exec boil.ContextExecutor) error {
+			var err error
+			imported, err = insertImportRows(ctx, exec, valid)
+			return err
+		})
+
+		var pqError *pq.Error
+		if !errors.As(err, &pqError) || pqError.Code.Name() != "exclusion_violation" {
+			if err != nil {
+				// Nothing is looked up, the service deleted meanwhile is a conflict
+				return nil, response.Database(err, "")
+			}
+			return imported, nil
+		}
+
+		if err := checkStoredOverlaps(ctx, boil.GetContextDB(), rows); err != nil {
+			return nil, response.Internal(err)
+		}
+
+		// Clashing subscription is deleted already, there is no row to blame
+		if len(getValidImportRows(rows)) == len(valid) {
+			return nil, response.Database(err, "")
+		}
+	}
+}
+
+// Get errors of all rows in row order
+func getImportErrors(rows []*importRow) []response.FieldError {
+
+	details := make([]response.FieldError, 0)
+	for _, row := range rows {
+		details = append(details, row.errors...)
+	}
+
+	return details
+}
+
+// Insert subscriptions and their prices with one statement per table. Identifiers are taken
+// from the sequence beforehand to link the prices to the subscriptions, public ones are generated along
+func insertImportRows(ctx context.Context, exec boil.ContextExecutor, rows []*importRow) ([]subscription_response.ImportedSubscription, error) {
+
+	ids, err := exec.QueryContext(ctx, `SELECT nextval(pg_get_serial_sequence('subscriptions', 'id')), gen_random_uuid()
+		FROM generate_series(1, $1)`, len(rows))
+	if err != nil {
+		return nil, err
+	}
+	defer ids.Close()
+
+	for i := 0; ids.Next(); i++ {
+		if err := ids.Scan(&rows[i].subscription.ID, &rows[i].subscription.UUID); err != nil {
+			return nil, err
+		}
+		rows[i].price.SubscriptionID = rows[i].subscription.ID
+	}
+
+	if err := ids.Err(); err != nil {
+		return nil, err
+	}
+
+	subscriptionIDs := make([]int64, len(rows))
+	subscriptionUUIDs := make([]string, len(rows))
+	userIDs := make([]string, len(rows))
+	serviceIDs := make([]int64, len(rows))
+	startDates := make([]time.Time, len(rows))
+	endDates := make([]null.Time, len(rows))
+	prices := make([]int64, len(rows))
+	currencies := make([]string, len(rows))
+	snapshots := make([]map[string]interface{}, len(rows))
+	imported := make([]subscription_response.ImportedSubscription, len(rows))
+
+	for i, row := range rows {
+		subscriptionIDs[i] = int64(row.subscription.ID)
+		subscriptionUUIDs[i] = row.subscription.UUID
+		userIDs[i] = row.subscription.UserID
+		serviceIDs[i] = int64(row.subscription.ServiceID)
+		startDates[i] = row.subscription.StartDate
+		endDates[i] = row.subscription.EndDate
+		prices[i] = row.price.Price
+		currencies[i] = row.price.Currency
+		snapshots[i] = audit.Snapshot(&row.subscription, models.SubscriptionPriceSlice{&row.price})
+		imported[i] = subscription_response.ImportedSubscription{Row: row.index, ID: row.subscription.UUID}
+	}
+
+	if err := provision.Users(ctx, exec, userIDs...); err != nil {
+		return nil, err
+	}
+
+	_, err = exec.ExecContext(ctx, `INSERT INTO subscriptions (id, uuid, user_id, service_id, start_date, end_date)
+		SELECT * FROM UNNEST($1::int[], $2::uuid[], $3::uuid[], $4::int[], $5::timestamptz[], $6::timestamptz[])`,
+		pq.Array(subscriptionIDs), pq.Array(subscriptionUUIDs), pq.Array(userIDs), pq.Array(serviceIDs), pq.Array(startDates), pq.Array(endDates),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = exec.ExecContext(ctx, `INSERT INTO subscription_prices (subscription_id, price, currency, effective_from)
+		SELECT * FROM UNNEST($1::int[], $2::bigint[], $3::text[], $4::timestamptz[])`,
+		pq.Array(subscriptionIDs), pq.Array(prices), pq.Array(currencies), pq.Array(startDates),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := audit.RecordInserts(ctx, exec, subscriptionIDs, subscriptionUUIDs, snapshots); err != nil {
+		return nil, err
+	}
+
+	return imported, nil
+}
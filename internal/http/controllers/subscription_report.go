@@ -0,0 +1,239 @@
+package controllers
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/zeleniy/test28/internal/currency"
+	"github.com/zeleniy/test28/internal/http/response"
+	subscription_response "github.com/zeleniy/test28/internal/http/response/subscription"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// Dimensions the accounting report can be grouped by
+const (
+	reportGroupByMonth   = "month"
+	reportGroupByService = "service"
+	reportGroupByUser    = "user"
+)
+
+// Monthly charges converted to the report currency with subtotals by the original currencies
+type reportTotals struct {
+	currency     string
+	rates        currency.Rates
+	subtotals    map[string]*subscription_response.ReportSubtotal
+	missing      map[string]bool
+	missingRates []subscription_response.MissingRate
+}
+
+func newReportTotals(code string, rates currency.Rates) *reportTotals {
+
+	return &reportTotals{
+		currency:     code,
+		rates:        rates,
+		subtotals:    make(map[string]*subscription_response.ReportSubtotal),
+		missing:      make(map[string]bool),
+		missingRates: make([]subscription_response.MissingRate, 0),
+	}
+}
+
+// Charge the price in the month converted at that month rate. Month without rate is recorded as a gap,
+// its charge is unknown
+func (t *reportTotals) charge(price *models.SubscriptionPrice, month time.Time) null.Int64 {
+
+	if price == nil {
+		return null.Int64From(0)
+	}
+
+	subtotal, ok := t.subtotals[price.Currency]
+	if !ok {
+		subtotal = &subscription_response.ReportSubtotal{Currency: price.Currency, Sum: null.Int64From(0)}
+		t.subtotals[price.Currency] = subtotal
+	}
+	subtotal.Months++
+	subtotal.Amount += price.Price
+
+	rate, ok := t.rates.Rate(price.Currency, t.currency, month)
+	if !ok {
+		if key := price.Currency + month.Format(response.MonthLayout); !t.missing[key] {
+			t.missing[key] = true
+			t.missingRates = append(t.missingRates, subscription_response.MissingRate{
+				Currency: price.Currency,
+				Month:    response.Month{Time: month},
+			})
+		}
+		subtotal.Sum = null.Int64{}
+		return null.Int64{}
+	}
+
+	charge := null.Int64From(currency.Convert(price.Price, price.Currency, t.currency, rate))
+	addCharge(&subtotal.Sum, charge)
+
+	return charge
+}
+
+// Add the charge to the sum. Sum including any unknown charge is unknown, so that it is never understated
+func addCharge(sum *null.Int64, charge null.Int64) {
+
+	if !charge.Valid {
+		*sum = null.Int64{}
+	} else if sum.Valid {
+		sum.Int64 += charge.Int64
+	}
+}
+
+// Get subtotals ordered by currency
+func (t *reportTotals) getSubtotals() []subscription_response.ReportSubtotal {
+
+	result := make([]subscription_response.ReportSubtotal, 0, len(t.subtotals))
+	for _, subtotal := range t.subtotals {
+		result = append(result, *subtotal)
+	}
+
+	slices.SortFunc(result, func(a, b subscription_response.ReportSubtotal) int {
+		return strings.Compare(a.Currency, b.Currency)
+	})
+
+	return result
+}
+
+// Get months without exchange rate ordered by currency and month
+func (t *reportTotals) getMissingRates() []subscription_response.MissingRate {
+
+	slices.SortFunc(t.missingRates, func(a, b subscription_response.MissingRate) int {
+		if a.Currency != b.Currency {
+			return strings.Compare(a.Currency, b.Currency)
+		}
+		return a.Month.Compare(b.Month.Time)
+	})
+
+	return t.missingRates
+}
+
+// Report row key, dimensions the report is not grouped by are left empty
+type reportRowKey struct {
+	month     time.Time
+	serviceID int
+	userUUID  string
+}
+
+// Accounting report breakdown by any combination of month, service and user
+type reportRows struct {
+	byMonth       bool
+	byService     bool
+	byUser        bool
+	rows          map[reportRowKey]*subscription_response.ReportRow
+	subscriptions map[reportRowKey]map[int]bool
+}
+
+func newReportRows(groupBy []string) *reportRows {
+
+	return &reportRows{
+		byMonth:       slices.Contains(groupBy, reportGroupByMonth),
+		byService:     slices.Contains(groupBy, reportGroupByService),
+		byUser:        slices.Contains(groupBy, reportGroupByUser),
+		rows:          make(map[reportRowKey]*subscription_response.ReportRow),
+		subscriptions: make(map[reportRowKey]map[int]bool),
+	}
+}
+
+// Add subscription charge in the month to its row
+func (r *reportRows) add(subscription *subscription_response.ReportSubscription, month time.Time, charge null.Int64) {
+
+	row := &subscription_response.ReportRow{Sum: null.Int64From(0)}
+	key := reportRowKey{}
+
+	if r.byMonth {
+		key.month = month
+		row.Month = &response.Month{Time: month}
+	}
+
+	if r.byService {
+		key.serviceID = subscription.ServiceID
+		row.ServiceID = &subscription.ServiceID
+		row.ServiceName = &subscription.ServiceName
+	}
+
+	if r.byUser {
+		key.userUUID = subscription.UserUUID
+		row.UserUUID = &subscription.UserUUID
+	}
+
+	if existing, ok := r.rows[key]; ok {
+		row = existing
+	} else {
+		r.rows[key] = row
+		r.subscriptions[key] = make(map[int]bool)
+	}
+
+	addCharge(&row.Sum, charge)
+
+	if !r.subscriptions[key][subscription.InternalID] {
+		r.subscriptions[key][subscription.InternalID] = true
+		row.Count++
+	}
+}
+
+// Get rows ordered by keys. When grouped by month, every series of the other keys gets a row
+// for each month of the period, months without active subscriptions are zero
+func (r *reportRows) getRows(first time.Time, last time.Time) []subscription_response.ReportRow {
+
+	if r.byMonth {
+		r.fillMonths(first, last)
+	}
+
+	result := make([]subscription_response.ReportRow, 0, len(r.rows))
+	for _, row := range r.rows {
+		result = append(result, *row)
+	}
+
+	slices.SortFunc(result, func(a, b subscription_response.ReportRow) int {
+		if a.Month != nil && !a.Month.Equal(b.Month.Time) {
+			return a.Month.Compare(b.Month.Time)
+		}
+		if a.ServiceName != nil && *a.ServiceName != *b.ServiceName {
+			return strings.Compare(*a.ServiceName, *b.ServiceName)
+		}
+		if a.ServiceID != nil && *a.ServiceID != *b.ServiceID {
+			return cmp.Compare(*a.ServiceID, *b.ServiceID)
+		}
+		if a.UserUUID != nil {
+			return strings.Compare(*a.UserUUID, *b.UserUUID)
+		}
+		return 0
+	})
+
+	return result
+}
+
+// Add zero rows for the months in which series have no active subscriptions
+func (r *reportRows) fillMonths(first time.Time, last time.Time) {
+
+	series := make(map[reportRowKey]subscription_response.ReportRow)
+
+	for key, row := range r.rows {
+		key.month = time.Time{}
+		series[key] = subscription_response.ReportRow{ServiceID: row.ServiceID, ServiceName: row.ServiceName, UserUUID: row.UserUUID}
+	}
+
+	// Report grouped by month only has a single series even without charges
+	if !r.byService && !r.byUser {
+		series[reportRowKey{}] = subscription_response.ReportRow{}
+	}
+
+	for key, template := range series {
+		for month := first; !month.After(last); month = month.AddDate(0, 1, 0) {
+			key.month = month
+			if _, ok := r.rows[key]; ok {
+				continue
+			}
+			row := template
+			row.Month = &response.Month{Time: month}
+			row.Sum = null.Int64From(0)
+			r.rows[key] = &row
+		}
+	}
+}
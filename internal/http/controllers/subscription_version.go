@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/http/request"
+	"github.com/zeleniy/test28/internal/http/response"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// Subscription is changed by a concurrent request after it was read
+var errSubscriptionModified = errors.New("subscription is modified by another request")
+
+// Entity tag of the subscription version
+func getSubscriptionETag(subscription *models.Subscription) string {
+
+	return `"` + strconv.Itoa(subscription.Version) + `"`
+}
+
+// Respond with 304 when the client already has the current subscription version
+func notModified(c *gin.Context, subscription *models.Subscription) bool {
+
+	etag := getSubscriptionETag(subscription)
+	c.Header("ETag", etag)
+
+	ifNoneMatch := c.GetHeader("If-None-Match")
+
+	return ifNoneMatch != "" && request.ETagMatches(ifNoneMatch, etag, true)
+}
+
+// Check that the subscription is changed in the version the client has read. Missing If-Match header is
+// rejected when it is configured to be required
+func (ctrl *SubscriptionController) checkIfMatch(c *gin.Context, subscription *models.Subscription) *response.Error {
+
+	ifMatch := c.GetHeader("If-Match")
+
+	if ifMatch == "" {
+		if ctrl.IfMatchRequired {
+			return response.PreconditionRequired("If-Match header with the subscription ETag is required")
+		}
+		return nil
+	}
+
+	if etag := getSubscriptionETag(subscription); !request.ETagMatches(ifMatch, etag, false) {
+		return response.PreconditionFailed("subscription is modified, its current ETag is " + etag)
+	}
+
+	return nil
+}
+
+// Increment subscription version unless a concurrent request has changed it since the subscription was read
+func incrementSubscriptionVersion(ctx context.Context, exec boil.ContextExecutor, subscription *models.Subscription) error {
+
+	updated, err := models.Subscriptions(
+		models.SubscriptionWhere.ID.EQ(subscription.ID),
+		models.SubscriptionWhere.Version.EQ(subscription.Version),
+		qm.WithDeleted(),
+	).UpdateAll(ctx, exec, models.M{models.SubscriptionColumns.Version: subscription.Version + 1})
+
+	if err != nil {
+		return err
+	}
+
+	if updated == 0 {
+		return errSubscriptionModified
+	}
+
+	subscription.Version++
+
+	return nil
+}
+
+// Convert lost race for the subscription version. It is a failed precondition for the client which has sent
+// If-Match and a plain conflict for the one which has not
+func getSubscriptionVersionError(c *gin.Context, err error) error {
+
+	if !errors.Is(err, errSubscriptionModified) {
+		return err
+	}
+
+	if c.GetHeader("If-Match") != "" {
+		return response.PreconditionFailed(err.Error())
+	}
+
+	return response.Conflict(err.Error())
+}
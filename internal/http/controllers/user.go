@@ -0,0 +1,222 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/http/request"
+	user_request "github.com/zeleniy/test28/internal/http/request/user"
+	"github.com/zeleniy/test28/internal/http/response"
+	subscription_response "github.com/zeleniy/test28/internal/http/response/subscription"
+	user_response "github.com/zeleniy/test28/internal/http/response/user"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+type UserController struct{}
+
+// Get users page, optionally filtered by login substring
+func (ctrl *UserController) GetUsers(c *gin.Context) {
+
+	var listRequest user_request.ListRequest
+
+	if err := c.ShouldBindQuery(&listRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	if listRequest.Limit == 0 {
+		listRequest.Limit = defaultPageLimit
+	}
+
+	var mods []qm.QueryMod
+
+	if listRequest.Login != nil {
+		mods = append(mods, qm.Where("users.login ILIKE ?", "%"+escapeLike(*listRequest.Login)+"%"))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	page, err := models.Users(append(mods,
+		qm.OrderBy("users.login, users.id"),
+		qm.Limit(listRequest.Limit+1),
+		qm.Offset(listRequest.Offset),
+	)...).All(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	pagination := response.Pagination{
+		Limit:  listRequest.Limit,
+		Offset: &listRequest.Offset,
+	}
+
+	if len(page) > listRequest.Limit {
+		page = page[:listRequest.Limit]
+		nextOffset := listRequest.Offset + listRequest.Limit
+		pagination.NextOffset = &nextOffset
+	}
+
+	users := make([]user_response.User, 0, len(page))
+
+	for _, user := range page {
+		users = append(users, user_response.NewUser(user))
+	}
+
+	c.Set("meta", map[string]interface{}{
+		"pagination": pagination,
+	})
+
+	c.Set("data", map[string]interface{}{
+		"users": users,
+	})
+}
+
+// Get user info
+func (ctrl *UserController) ReadUser(c *gin.Context) {
+
+	var request request.UUIDRequest
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	user, err := models.Users(models.UserWhere.UUID.EQ(request.UUID)).One(c.Request.Context(), boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Database(err, "user not found"))
+		return
+	}
+
+	c.Set("data", map[string]interface{}{
+		"user": user_response.NewUser(user),
+	})
+}
+
+// Get user's subscriptions page, optionally filtered by status in the current month
+func (ctrl *UserController) GetUserSubscriptions(c *gin.Context) {
+
+	var uuidRequest request.UUIDRequest
+
+	if err := c.ShouldBindUri(&uuidRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	var subscriptionsRequest user_request.SubscriptionsRequest
+
+	if err := c.ShouldBindQuery(&subscriptionsRequest); err != nil {
+		c.Error(response.Validation(err))
+		return
+	}
+
+	if apiError := authorizeWithDeleted(c, subscriptionsRequest.WithDeleted); apiError != nil {
+		c.Error(apiError)
+		return
+	}
+
+	if subscriptionsRequest.Limit == 0 {
+		subscriptionsRequest.Limit = defaultPageLimit
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	user, err := models.Users(models.UserWhere.UUID.EQ(uuidRequest.UUID)).One(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Database(err, "user not found"))
+		return
+	}
+
+	mods := []qm.QueryMod{
+		qm.Load(models.SubscriptionRels.Service),
+		qm.Load(models.SubscriptionRels.SubscriptionPrices),
+		qm.OrderBy("subscriptions.start_date, subscriptions.id"),
+		qm.Limit(subscriptionsRequest.Limit + 1),
+		qm.Offset(subscriptionsRequest.Offset),
+	}
+
+	if len(subscriptionsRequest.Status) > 0 {
+		mods = append(mods, getSubscriptionStatusCriteria(subscriptionsRequest.Status, truncateToMonth(time.Now())))
+	}
+
+	if subscriptionsRequest.WithDeleted {
+		mods = append(mods, qm.WithDeleted())
+	}
+
+	page, err := user.Subscriptions(mods...).All(ctx, boil.GetContextDB())
+
+	if err != nil {
+		c.Error(response.Internal(err))
+		return
+	}
+
+	pagination := response.Pagination{
+		Limit:  subscriptionsRequest.Limit,
+		Offset: &subscriptionsRequest.Offset,
+	}
+
+	if len(page) > subscriptionsRequest.Limit {
+		page = page[:subscriptionsRequest.Limit]
+		nextOffset := subscriptionsRequest.Offset + subscriptionsRequest.Limit
+		pagination.NextOffset = &nextOffset
+	}
+
+	subscriptions := make([]subscription_response.UserSubscription, 0, len(page))
+
+	for _, subscription := range page {
+		subscriptions = append(subscriptions, subscription_response.NewUserSubscription(subscription, subscription.R.Service))
+	}
+
+	c.Set("meta", map[string]interface{}{
+		"pagination": pagination,
+	})
+
+	c.Set("data", map[string]interface{}{
+		"user":          user_response.NewUser(user),
+		"subscriptions": subscriptions,
+	})
+}
+
+// Select subscriptions in any of the statuses in the month: active in it, starting after it or ended before it
+func getSubscriptionStatusCriteria(statuses []string, month time.Time) qm.QueryMod {
+
+	var criteria []qm.QueryMod
+
+	for _, status := range statuses {
+		var expression qm.QueryMod
+
+		switch status {
+		case user_request.StatusActive:
+			expression = qm.Expr(
+				models.SubscriptionWhere.StartDate.LT(month.AddDate(0, 1, 0)),
+				qm.Expr(
+					models.SubscriptionWhere.EndDate.IsNull(),
+					qm.Or2(models.SubscriptionWhere.EndDate.GTE(null.TimeFrom(month))),
+				),
+			)
+		case user_request.StatusScheduled:
+			expression = models.SubscriptionWhere.StartDate.GTE(month.AddDate(0, 1, 0))
+		case user_request.StatusEnded:
+			expression = models.SubscriptionWhere.EndDate.LT(null.TimeFrom(month))
+		default:
+			continue
+		}
+
+		if len(criteria) > 0 {
+			expression = qm.Or2(expression)
+		}
+
+		criteria = append(criteria, expression)
+	}
+
+	return qm.Expr(criteria...)
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/audit"
+	"github.com/zeleniy/test28/internal/auth"
+	"github.com/zeleniy/test28/internal/http/response"
+)
+
+const authenticateHeader = "WWW-Authenticate"
+
+// Context key of the credentials rejected by the authentication
+const authFailureKey = "auth_failure"
+
+// Rejected credentials, reported only where the user is required
+type authFailure struct {
+	challenge string
+	err       *response.Error
+}
+
+// Authenticate the user by the bearer access token and make them the actor of the audited changes.
+// Requests without the token or with the bad one proceed anonymously, routes requiring the user
+// are guarded by RequireUserMiddleware, which also reports why the token is rejected
+func AuthenticationMiddleware(issuer *auth.Issuer, logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+
+		authorization := c.GetHeader("Authorization")
+		if authorization == "" {
+			c.Next()
+			return
+		}
+
+		scheme, token, _ := strings.Cut(authorization, " ")
+		if !strings.EqualFold(scheme, "Bearer") || token == "" {
+			c.Set(authFailureKey, &authFailure{
+				challenge: `Bearer error="invalid_request"`,
+				err:       response.Unauthorized("Authorization header must contain bearer token"),
+			})
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+
+		user, claims, err := issuer.Authenticate(ctx, boil.GetContextDB(), token)
+		if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, auth.ErrExpiredToken) {
+			c.Set(authFailureKey, &authFailure{
+				challenge: `Bearer error="invalid_token"`,
+				err:       response.Unauthorized(err.Error()),
+			})
+			c.Next()
+			return
+		}
+		if err != nil {
+			renderError(c, logger, err)
+			c.Abort()
+			return
+		}
+
+		c.Set("user", user)
+		c.Set("session_id", claims.SessionID)
+		c.Request = c.Request.WithContext(audit.WithActor(ctx, user.UUID))
+
+		c.Next()
+	}
+}
+
+// Reject the request made anonymously, telling why the token is rejected if there is one
+func RequireUserMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+
+		if _, exists := c.Get("user"); !exists {
+			failure := &authFailure{challenge: "Bearer", err: response.Unauthorized("authentication required")}
+			if rejected, ok := c.Get(authFailureKey); ok {
+				failure = rejected.(*authFailure)
+			}
+			c.Header(authenticateHeader, failure.challenge)
+			c.Error(failure.err)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
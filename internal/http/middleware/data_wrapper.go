@@ -1,31 +1,97 @@
 package middleware
 
 import (
+	"errors"
+	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/http/response"
 )
 
-func DataWrapperMiddleware() gin.HandlerFunc {
+func DataWrapperMiddleware(logger *slog.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 
 		c.Writer.Header().Set("Content-Type", "application/json")
 		c.Next()
 
+		if len(c.Errors) > 0 {
+			renderError(c, logger, c.Errors.Last().Err)
+			return
+		}
+
 		data, exists := c.Get("data")
 		if !exists {
 			return
 		}
 
-		response := map[string]interface{}{
-			"data": data,
-			"meta": map[string]interface{}{
-				"timestamp": time.Now(),
-			},
+		c.JSON(c.Writer.Status(), map[string]interface{}{
+			"data":  data,
+			"meta":  getMeta(c),
 			"error": nil,
-		}
+		})
+	}
+}
+
+// Render error as envelope or as RFC 7807 problem details if client asks for it
+func renderError(c *gin.Context, logger *slog.Logger, err error) {
+
+	var apiError *response.Error
+
+	if !errors.As(err, &apiError) {
+		apiError = response.Internal(err)
+	}
+
+	level := slog.LevelInfo
+	if apiError.Status >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
 
-		c.JSON(http.StatusOK, response)
+	logger.LogAttrs(c.Request.Context(), level, "request failed",
+		slog.String("handler", c.HandlerName()),
+		slog.String("code", apiError.Code),
+		slog.Int("status", apiError.Status),
+		slog.String("error", apiError.Error()),
+	)
+
+	// Streamed response is already partially sent and can not be replaced
+	if c.Writer.Written() {
+		return
+	}
+
+	if strings.Contains(c.GetHeader("Accept"), "application/problem+json") {
+		c.Writer.Header().Set("Content-Type", "application/problem+json")
+		c.JSON(apiError.Status, map[string]interface{}{
+			"type":   "about:blank",
+			"title":  http.StatusText(apiError.Status),
+			"status": apiError.Status,
+			"detail": apiError.Message,
+			"code":   apiError.Code,
+			"errors": apiError.Details,
+		})
+		return
+	}
+
+	c.JSON(apiError.Status, map[string]interface{}{
+		"data":  nil,
+		"meta":  getMeta(c),
+		"error": apiError,
+	})
+}
+
+func getMeta(c *gin.Context) map[string]interface{} {
+
+	meta := map[string]interface{}{
+		"timestamp": time.Now(),
+	}
+
+	if extraMeta, ok := c.Get("meta"); ok {
+		for key, value := range extraMeta.(map[string]interface{}) {
+			meta[key] = value
+		}
 	}
+
+	return meta
 }
@@ -0,0 +1,222 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/audit"
+	"github.com/zeleniy/test28/internal/config"
+	"github.com/zeleniy/test28/internal/http/request"
+	"github.com/zeleniy/test28/internal/http/response"
+	"github.com/zeleniy/test28/internal/idempotency"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// Set on the stored response sent again
+const idempotentReplayedHeader = "Idempotent-Replayed"
+
+// Longest key the storage accepts
+const idempotencyKeyMaxLength = 255
+
+// Interval between checks whether the request holding the key is completed
+const idempotencyPollInterval = 50 * time.Millisecond
+
+// Methods changing the data, safe methods are repeatable by definition
+var idempotentMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// Response writer keeping a copy of the body
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+
+	w.body.Write(data)
+
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(data string) (int, error) {
+
+	w.body.WriteString(data)
+
+	return w.ResponseWriter.WriteString(data)
+}
+
+// Store the response to the request with Idempotency-Key header and send it again when the request is repeated.
+// Must run after the authentication, as the key is scoped to the actor, and before the envelope is rendered.
+// Server errors are not stored, so such requests can be retried. Responses marked "Cache-Control: no-store",
+// such as the issued tokens, are not stored either
+func IdempotencyMiddleware(cfg config.IdempotencyConfig, logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+
+		key := c.GetHeader(idempotencyKeyHeader)
+		if key == "" || !slices.Contains(idempotentMethods, c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		if len(key) > idempotencyKeyMaxLength {
+			renderError(c, logger, response.InvalidRequest("Idempotency-Key header is longer than 255 characters"))
+			c.Abort()
+			return
+		}
+
+		// Body is read in full before any handler limits it
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, request.MaxBodySize)
+
+		body, err := c.GetRawData()
+		if err != nil {
+			var maxBytesError *http.MaxBytesError
+			if errors.As(err, &maxBytesError) {
+				err = response.PayloadTooLarge(fmt.Sprintf("request body is limited to %d bytes", maxBytesError.Limit))
+			} else {
+				err = response.InvalidRequest("cannot read request body")
+			}
+			renderError(c, logger, err)
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		requestHash := idempotency.Hash(c.Request.Method, c.Request.URL.RequestURI(), body)
+
+		stored, err := claimIdempotencyKey(ctx, cfg, key, requestHash)
+		if err != nil {
+			renderError(c, logger, err)
+			c.Abort()
+			return
+		}
+
+		if stored != nil {
+			if err := replayResponse(c, stored); err != nil {
+				renderError(c, logger, err)
+			}
+			c.Abort()
+			return
+		}
+
+		writer := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		// Key is kept by the completed request only, client is free to retry the one which has panicked
+		completed := false
+		defer func() {
+			if !completed {
+				releaseIdempotencyKey(ctx, logger, key)
+			}
+		}()
+
+		c.Next()
+
+		completed = true
+
+		if writer.Status() >= http.StatusInternalServerError || isNoStore(writer.Header()) {
+			releaseIdempotencyKey(ctx, logger, key)
+			return
+		}
+
+		header := writer.Header().Clone()
+		header.Del(requestIDHeader)
+
+		err = idempotency.Complete(context.WithoutCancel(ctx), boil.GetContextDB(), audit.Actor(ctx), key, writer.Status(), header, writer.body.Bytes(), cfg.TTL)
+		if err != nil {
+			logger.ErrorContext(ctx, "cannot store idempotent response", slog.String("error", err.Error()))
+			releaseIdempotencyKey(ctx, logger, key)
+		}
+	}
+}
+
+// Take the key for the request or wait until the request holding it is completed and get its response
+func claimIdempotencyKey(ctx context.Context, cfg config.IdempotencyConfig, key, requestHash string) (*models.IdempotencyKey, error) {
+
+	deadline := time.Now().Add(cfg.Wait)
+
+	for {
+		claimed, err := idempotency.Claim(ctx, boil.GetContextDB(), audit.Actor(ctx), key, requestHash, cfg.LockTimeout)
+		if err != nil || claimed {
+			return nil, err
+		}
+
+		// Key is released or expired since the claim attempt when it is not found
+		stored, err := idempotency.Find(ctx, boil.GetContextDB(), audit.Actor(ctx), key)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		if stored != nil {
+			if stored.RequestHash != requestHash {
+				return nil, response.Unprocessable("Idempotency-Key is already used for another request")
+			}
+			if stored.Status.Valid {
+				return stored, nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return nil, response.Conflict("request with the same Idempotency-Key is in progress")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(idempotencyPollInterval):
+		}
+	}
+}
+
+// Send the stored response again
+func replayResponse(c *gin.Context, stored *models.IdempotencyKey) error {
+
+	header, err := idempotency.Header(stored)
+	if err != nil {
+		return err
+	}
+
+	for name, values := range header {
+		c.Writer.Header()[name] = values
+	}
+	c.Header(idempotentReplayedHeader, "true")
+
+	c.Writer.WriteHeader(stored.Status.Int)
+	c.Writer.WriteHeaderNow()
+	_, err = c.Writer.Write(stored.ResponseBody.Bytes)
+
+	return err
+}
+
+// Check whether the response must not be kept anywhere
+func isNoStore(header http.Header) bool {
+
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(directive), "no-store") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func releaseIdempotencyKey(ctx context.Context, logger *slog.Logger, key string) {
+
+	if err := idempotency.Release(context.WithoutCancel(ctx), boil.GetContextDB(), audit.Actor(ctx), key); err != nil {
+		logger.ErrorContext(ctx, "cannot release idempotency key", slog.String("error", err.Error()))
+	}
+}
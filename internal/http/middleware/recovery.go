@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Recover from panic rendering internal error instead of empty response
+func RecoveryMiddleware(logger *slog.Logger) gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, recovered any) {
+		renderError(c, logger, fmt.Errorf("panic: %v\n%s", recovered, debug.Stack()))
+		c.Abort()
+	})
+}
@@ -0,0 +1,6 @@
+package auth_request
+
+type LoginRequest struct {
+	Login    string `json:"login" binding:"required,max=32"`
+	Password string `json:"password" binding:"required,max=72"`
+}
@@ -0,0 +1,5 @@
+package auth_request
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
@@ -0,0 +1,5 @@
+package request
+
+// Largest request body any route accepts, the import takes the most: a bit more than its maximum number of rows.
+// Idempotent requests are read into memory in full for hashing, so the limit is applied to them as well
+const MaxBodySize = 8 << 20
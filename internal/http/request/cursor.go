@@ -0,0 +1,36 @@
+package request
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// Keyset pagination position on (created_at, id)
+type Cursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        int       `json:"id"`
+}
+
+// Encode cursor to opaque URL safe string
+func (cursor Cursor) Encode() string {
+
+	data, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode cursor from opaque URL safe string
+func DecodeCursor(value string) (Cursor, error) {
+
+	var cursor Cursor
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, err
+	}
+
+	err = json.Unmarshal(data, &cursor)
+
+	return cursor, err
+}
@@ -0,0 +1,6 @@
+package request
+
+// Soft deleted records are included on request
+type WithDeletedRequest struct {
+	WithDeleted bool `form:"with_deleted"`
+}
@@ -0,0 +1,27 @@
+package request
+
+import "strings"
+
+// Check whether If-Match or If-None-Match header lists the entity tag or is "*". Weak comparison ignores
+// the W/ prefix as If-None-Match requires, strong comparison used by If-Match never matches weak tags
+func ETagMatches(header, etag string, weak bool) bool {
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+
+		if candidate == "*" {
+			return true
+		}
+
+		if weak {
+			candidate = strings.TrimPrefix(candidate, "W/")
+			etag = strings.TrimPrefix(etag, "W/")
+		}
+
+		if candidate == etag && !strings.HasPrefix(candidate, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
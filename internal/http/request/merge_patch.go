@@ -0,0 +1,45 @@
+package request
+
+import "encoding/json"
+
+// Media type of the JSON merge patch document
+const MergePatchContentType = "application/merge-patch+json"
+
+// Apply JSON merge patch (RFC 7396) to the JSON document
+func MergePatch(document, patch []byte) ([]byte, error) {
+
+	var target, changes interface{}
+
+	if err := json.Unmarshal(document, &target); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(patch, &changes); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergePatch(target, changes))
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = mergePatch(targetObject[key], value)
+		}
+	}
+
+	return targetObject
+}
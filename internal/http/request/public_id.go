@@ -0,0 +1,6 @@
+package request
+
+// Resource addressed by its public UUID under the id path parameter
+type PublicIdRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
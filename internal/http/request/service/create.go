@@ -0,0 +1,5 @@
+package service_request
+
+type CreateRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=255"`
+}
@@ -0,0 +1,7 @@
+package service_request
+
+type ListRequest struct {
+	Name   *string `form:"name" binding:"omitempty,min=1,max=255"`
+	Limit  int     `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int     `form:"offset" binding:"omitempty,min=0"`
+}
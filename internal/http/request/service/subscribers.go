@@ -0,0 +1,7 @@
+package service_request
+
+type SubscribersRequest struct {
+	Limit  int  `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int  `form:"offset" binding:"omitempty,min=0"`
+	Active bool `form:"active"`
+}
@@ -1,7 +1,11 @@
 package subscription_request
 
 type CreateRequest struct {
-	UserUUID    string `json:"user_id" binding:"required,len=36"`
-	ServiceName string `json:"service_name" binding:"required"`
-	Price       int    `json:"price" binding:"required,gt=0"`
+	UserUUID    string  `json:"user_id" binding:"required,uuid"`
+	ServiceID   *int    `json:"service_id" binding:"required_without=ServiceName,excluded_with=ServiceName,omitempty,gt=0"`
+	ServiceName *string `json:"service_name" binding:"required_without=ServiceID,omitempty,min=1,max=255"`
+	Price       int64   `json:"price" binding:"required,gt=0"`
+	Currency    string  `json:"currency" binding:"omitempty,iso4217"`
+	StartDate   string  `json:"start_date" binding:"required,regex=^\\d{2}-\\d{4}$,date=01-2006"`
+	EndDate     *string `json:"end_date" binding:"omitempty,regex=^\\d{2}-\\d{4}$,date=01-2006,monthgtefield=StartDate"`
 }
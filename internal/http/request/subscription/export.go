@@ -0,0 +1,24 @@
+package subscription_request
+
+type ExportRequest struct {
+	UserUUID    *string `form:"user_id" binding:"omitempty,uuid"`
+	ServiceID   *int    `form:"service_id" binding:"omitempty,gt=0,excluded_with=ServiceName"`
+	ServiceName *string `form:"service_name" binding:"omitempty,min=1,max=255"`
+	From        *string `form:"from_date" binding:"omitempty,regex=^\\d{2}-\\d{2}-\\d{4}$,date=02-01-2006"`
+	To          *string `form:"to_date" binding:"omitempty,regex=^\\d{2}-\\d{2}-\\d{4}$,date=02-01-2006"`
+	Format      *string `form:"format" binding:"omitempty,oneof=csv ndjson"`
+	WithDeleted bool    `form:"with_deleted"`
+}
+
+// Same filters as the accounting report has
+func (r ExportRequest) ReportRequest() ReportRequest {
+
+	return ReportRequest{
+		UserUUID:    r.UserUUID,
+		ServiceID:   r.ServiceID,
+		ServiceName: r.ServiceName,
+		From:        r.From,
+		To:          r.To,
+		WithDeleted: r.WithDeleted,
+	}
+}
@@ -0,0 +1,6 @@
+package subscription_request
+
+type HistoryRequest struct {
+	Limit  int     `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor *string `form:"cursor" binding:"omitempty,min=1"`
+}
@@ -0,0 +1,11 @@
+package subscription_request
+
+// Import modes: invalid row rejects the whole batch or is skipped
+const (
+	ImportModeAllOrNothing = "all_or_nothing"
+	ImportModeBestEffort   = "best_effort"
+)
+
+type ImportRequest struct {
+	Mode string `form:"mode" binding:"omitempty,oneof=all_or_nothing best_effort"`
+}
@@ -0,0 +1,10 @@
+package subscription_request
+
+type ListRequest struct {
+	Limit       int     `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor      *string `form:"cursor" binding:"omitempty,min=1"`
+	Offset      *int    `form:"offset" binding:"omitempty,min=0"`
+	Sort        *string `form:"sort" binding:"omitempty,min=1"`
+	WithTotal   bool    `form:"with_total"`
+	WithDeleted bool    `form:"with_deleted"`
+}
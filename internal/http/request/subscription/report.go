@@ -1,8 +1,13 @@
 package subscription_request
 
 type ReportRequest struct {
-	UserUUID    *string `json:"user_id" binding:"omitempty,len=36"`
-	ServiceName *string `json:"service_name" binding:"omitempty,min=1,max=255"`
-	From        *string `json:"from_date" binding:"omitempty,regex=^\\d{2}-\\d{2}-\\d{4}$,date=02-01-2006"`
-	To          *string `json:"to_date" binding:"omitempty,regex=^\\d{2}-\\d{2}-\\d{4}$,date=02-01-2006"`
+	UserUUID    *string  `json:"user_id" binding:"omitempty,uuid"`
+	ServiceID   *int     `json:"service_id" binding:"omitempty,gt=0,excluded_with=ServiceName"`
+	ServiceName *string  `json:"service_name" binding:"omitempty,min=1,max=255"`
+	From        *string  `json:"from_date" binding:"omitempty,regex=^\\d{2}-\\d{2}-\\d{4}$,date=02-01-2006"`
+	To          *string  `json:"to_date" binding:"omitempty,regex=^\\d{2}-\\d{2}-\\d{4}$,date=02-01-2006"`
+	Currency    *string  `json:"currency" binding:"omitempty,iso4217"`
+	GroupBy     []string `json:"group_by" binding:"omitempty,unique,dive,oneof=month service user"`
+	// Taken from the with_deleted query parameter
+	WithDeleted bool `json:"-"`
 }
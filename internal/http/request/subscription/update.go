@@ -0,0 +1,13 @@
+package subscription_request
+
+type UpdateRequest struct {
+	UserUUID    string  `json:"user_id" binding:"required,uuid"`
+	ServiceID   *int    `json:"service_id,omitempty" binding:"required_without=ServiceName,excluded_with=ServiceName,omitempty,gt=0"`
+	ServiceName *string `json:"service_name,omitempty" binding:"required_without=ServiceID,omitempty,min=1,max=255"`
+	Price       int64   `json:"price" binding:"required,gt=0"`
+	Currency    string  `json:"currency,omitempty" binding:"omitempty,iso4217"`
+	StartDate   string  `json:"start_date" binding:"required,regex=^\\d{2}-\\d{4}$,date=01-2006"`
+	EndDate     *string `json:"end_date,omitempty" binding:"omitempty,regex=^\\d{2}-\\d{4}$,date=01-2006,monthgtefield=StartDate"`
+	// Month the new price takes effect from, the current month by default
+	PriceEffectiveFrom *string `json:"price_effective_from,omitempty" binding:"omitempty,regex=^\\d{2}-\\d{4}$,date=01-2006,monthgtefield=StartDate"`
+}
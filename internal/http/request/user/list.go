@@ -0,0 +1,7 @@
+package user_request
+
+type ListRequest struct {
+	Login  *string `form:"login" binding:"omitempty,min=1,max=255"`
+	Limit  int     `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int     `form:"offset" binding:"omitempty,min=0"`
+}
@@ -0,0 +1,15 @@
+package user_request
+
+// Subscription states relative to the current month
+const (
+	StatusActive    = "active"
+	StatusScheduled = "scheduled"
+	StatusEnded     = "ended"
+)
+
+type SubscriptionsRequest struct {
+	Limit       int      `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset      int      `form:"offset" binding:"omitempty,min=0"`
+	Status      []string `form:"status" binding:"omitempty,dive,oneof=active scheduled ended"`
+	WithDeleted bool     `form:"with_deleted"`
+}
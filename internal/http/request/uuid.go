@@ -1,5 +1,5 @@
 package request
 
 type UUIDRequest struct {
-	UUID int `uri:"id" binding:"required,len=36"`
+	UUID string `uri:"uuid" binding:"required,uuid"`
 }
@@ -0,0 +1,24 @@
+package auth_response
+
+import (
+	"time"
+
+	"github.com/zeleniy/test28/internal/auth"
+)
+
+// Token pair in the OAuth 2.0 token response shape
+type Tokens struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+func NewTokens(tokens auth.Tokens) Tokens {
+	return Tokens{
+		AccessToken:  tokens.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(time.Until(tokens.ExpiresAt).Round(time.Second).Seconds()),
+		RefreshToken: tokens.RefreshToken,
+	}
+}
@@ -0,0 +1,204 @@
+package response
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lib/pq"
+)
+
+// Stable machine readable error codes
+const (
+	CodeInvalidRequest   = "invalid_request"
+	CodeValidationFailed = "validation_failed"
+	CodeUnauthorized     = "unauthorized"
+	CodeForbidden        = "forbidden"
+	CodeNotFound         = "not_found"
+	CodeConflict         = "conflict"
+	CodeUnprocessable    = "unprocessable_entity"
+	CodePrecondition     = "precondition_failed"
+	CodeNoPrecondition   = "precondition_required"
+	CodeUnsupportedMedia = "unsupported_media_type"
+	CodeTooLarge         = "payload_too_large"
+	CodeInternal         = "internal_error"
+)
+
+// Integrity constraint violations caused by the concurrent or conflicting data
+var conflictErrorCodes = []string{"unique_violation", "foreign_key_violation", "exclusion_violation"}
+
+// API error rendered into the response envelope
+type Error struct {
+	Status  int          `json:"-"`
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Details []FieldError `json:"details,omitempty"`
+	Cause   error        `json:"-"`
+}
+
+// Single field validation failure
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+
+	if e.Cause != nil {
+		return e.Message + ": " + e.Cause.Error()
+	}
+
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Malformed request which can not be parsed at all
+func InvalidRequest(message string) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: CodeInvalidRequest, Message: message}
+}
+
+// Request lacks valid credentials
+func Unauthorized(message string) *Error {
+	return &Error{Status: http.StatusUnauthorized, Code: CodeUnauthorized, Message: message}
+}
+
+// Authenticated user is not allowed to make the request
+func Forbidden(message string) *Error {
+	return &Error{Status: http.StatusForbidden, Code: CodeForbidden, Message: message}
+}
+
+// Requested resource does not exist
+func NotFound(message string) *Error {
+	return &Error{Status: http.StatusNotFound, Code: CodeNotFound, Message: message}
+}
+
+// Request conflicts with the current state of the resource
+func Conflict(message string) *Error {
+	return &Error{Status: http.StatusConflict, Code: CodeConflict, Message: message}
+}
+
+// Well-formed request which can not be processed as it is
+func Unprocessable(message string) *Error {
+	return &Error{Status: http.StatusUnprocessableEntity, Code: CodeUnprocessable, Message: message}
+}
+
+// Resource is changed since the client has read it
+func PreconditionFailed(message string) *Error {
+	return &Error{Status: http.StatusPreconditionFailed, Code: CodePrecondition, Message: message}
+}
+
+// Change must be conditional on the resource version the client has read
+func PreconditionRequired(message string) *Error {
+	return &Error{Status: http.StatusPreconditionRequired, Code: CodeNoPrecondition, Message: message}
+}
+
+// Request body is of the media type the endpoint does not accept
+func UnsupportedMediaType(message string) *Error {
+	return &Error{Status: http.StatusUnsupportedMediaType, Code: CodeUnsupportedMedia, Message: message}
+}
+
+// Request body exceeds the size the endpoint accepts
+func PayloadTooLarge(message string) *Error {
+	return &Error{Status: http.StatusRequestEntityTooLarge, Code: CodeTooLarge, Message: message}
+}
+
+// Unexpected failure, the cause is never exposed to the client
+func Internal(err error) *Error {
+	return &Error{Status: http.StatusInternalServerError, Code: CodeInternal, Message: "internal server error", Cause: err}
+}
+
+// Single field failed validation outside of the binding
+func InvalidField(field, rule, message string) *Error {
+	return &Error{
+		Status:  http.StatusBadRequest,
+		Code:    CodeValidationFailed,
+		Message: "request validation failed",
+		Details: []FieldError{{Field: field, Rule: rule, Message: message}},
+	}
+}
+
+// Rows of a batch failed validation, fields are prefixed with the row index
+func InvalidRows(message string, details []FieldError) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: CodeValidationFailed, Message: message, Details: details}
+}
+
+// Convert binding error into validation error with field details
+func Validation(err error) *Error {
+
+	var validationErrors validator.ValidationErrors
+
+	if !errors.As(err, &validationErrors) {
+		var syntaxError *json.SyntaxError
+		var typeError *json.UnmarshalTypeError
+
+		switch {
+		case errors.As(err, &syntaxError):
+			return InvalidRequest("malformed JSON")
+		case errors.As(err, &typeError):
+			return &Error{
+				Status:  http.StatusBadRequest,
+				Code:    CodeValidationFailed,
+				Message: "request validation failed",
+				Details: []FieldError{{Field: typeError.Field, Rule: "type", Message: "must be " + typeError.Type.String()}},
+				Cause:   err,
+			}
+		}
+
+		return InvalidRequest(err.Error())
+	}
+
+	details := make([]FieldError, 0, len(validationErrors))
+
+	for _, fieldError := range validationErrors {
+		message := fmt.Sprintf("failed on '%s' rule", fieldError.Tag())
+		if fieldError.Param() != "" {
+			message = fmt.Sprintf("failed on '%s=%s' rule", fieldError.Tag(), fieldError.Param())
+		}
+
+		details = append(details, FieldError{
+			Field:   fieldError.Field(),
+			Rule:    fieldError.Tag(),
+			Message: message,
+		})
+	}
+
+	return &Error{
+		Status:  http.StatusBadRequest,
+		Code:    CodeValidationFailed,
+		Message: "request validation failed",
+		Details: details,
+		Cause:   err,
+	}
+}
+
+// Convert database error, hiding driver details from the client. API error returned from a transaction is kept as is
+func Database(err error, notFoundMessage string) *Error {
+
+	var apiError *Error
+
+	if errors.As(err, &apiError) {
+		return apiError
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return NotFound(notFoundMessage)
+	}
+
+	var pqError *pq.Error
+
+	if errors.As(err, &pqError) && slices.Contains(conflictErrorCodes, pqError.Code.Name()) {
+		conflict := Conflict("request conflicts with existing data")
+		conflict.Cause = err
+		return conflict
+	}
+
+	return Internal(err)
+}
@@ -0,0 +1,34 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+// Subscription periods are measured in months
+const MonthLayout = "01-2006"
+
+// Month serialized in MM-YYYY format
+type Month struct {
+	time.Time
+}
+
+func (m Month) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Time.Format(MonthLayout))
+}
+
+// Optional month serialized in MM-YYYY format or as null
+type NullMonth struct {
+	null.Time
+}
+
+func (m NullMonth) MarshalJSON() ([]byte, error) {
+
+	if !m.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(m.Time.Time.Format(MonthLayout))
+}
@@ -0,0 +1,9 @@
+package response
+
+type Pagination struct {
+	Limit      int     `json:"limit"`
+	Offset     *int    `json:"offset,omitempty"`
+	NextOffset *int    `json:"next_offset,omitempty"`
+	NextCursor *string `json:"next_cursor"`
+	Total      *int64  `json:"total,omitempty"`
+}
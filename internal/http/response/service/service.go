@@ -0,0 +1,21 @@
+package service_response
+
+import (
+	"time"
+
+	"github.com/zeleniy/test28/internal/models"
+)
+
+type Service struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewService(service *models.Service) Service {
+	return Service{
+		ID:        service.ID,
+		Name:      service.Name,
+		CreatedAt: service.CreatedAt,
+	}
+}
@@ -0,0 +1,31 @@
+package service_response
+
+import (
+	"github.com/zeleniy/test28/internal/http/response"
+	"github.com/zeleniy/test28/internal/models"
+	"github.com/zeleniy/test28/internal/pricing"
+)
+
+// User subscribed to the service
+type Subscriber struct {
+	UserUUID       string             `json:"user_id"`
+	SubscriptionID string             `json:"subscription_id"`
+	Price          int64              `json:"price"`
+	Currency       string             `json:"currency"`
+	StartDate      response.Month     `json:"start_date"`
+	EndDate        response.NullMonth `json:"end_date"`
+}
+
+func NewSubscriber(subscription *models.Subscription) Subscriber {
+
+	price := pricing.Current(subscription.R.GetSubscriptionPrices())
+
+	return Subscriber{
+		UserUUID:       subscription.UserID,
+		SubscriptionID: subscription.UUID,
+		Price:          price.Price,
+		Currency:       price.Currency,
+		StartDate:      response.Month{Time: subscription.StartDate},
+		EndDate:        response.NullMonth{Time: subscription.EndDate},
+	}
+}
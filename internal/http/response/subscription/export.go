@@ -0,0 +1,80 @@
+package subscription_response
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/zeleniy/test28/internal/http/response"
+)
+
+// Columns of the CSV export in the order of ExportSubscription.CSVRecord
+var ExportCSVHeader = []string{"id", "user_id", "service_id", "service_name", "price", "currency", "start_date", "end_date", "created_at", "deleted_at"}
+
+// Exported subscription with the price in effect in the current month
+type ExportSubscription struct {
+	ID          string             `json:"id"`
+	UserUUID    string             `json:"user_id"`
+	ServiceID   int                `json:"service_id"`
+	ServiceName string             `json:"service_name"`
+	Price       int64              `json:"price"`
+	Currency    string             `json:"currency"`
+	StartDate   response.Month     `json:"start_date"`
+	EndDate     response.NullMonth `json:"end_date"`
+	CreatedAt   time.Time          `json:"created_at"`
+	DeletedAt   null.Time          `json:"deleted_at"`
+}
+
+// Scan the current row of the export query
+func ScanExportSubscription(rows *sql.Rows) (ExportSubscription, error) {
+
+	var subscription ExportSubscription
+	var startDate time.Time
+	var endDate null.Time
+
+	err := rows.Scan(
+		&subscription.ID,
+		&subscription.UserUUID,
+		&subscription.ServiceID,
+		&subscription.ServiceName,
+		&subscription.Price,
+		&subscription.Currency,
+		&startDate,
+		&endDate,
+		&subscription.CreatedAt,
+		&subscription.DeletedAt,
+	)
+
+	subscription.StartDate = response.Month{Time: startDate}
+	subscription.EndDate = response.NullMonth{Time: endDate}
+
+	return subscription, err
+}
+
+// Format subscription as CSV record
+func (s ExportSubscription) CSVRecord() []string {
+
+	endDate := ""
+	if s.EndDate.Valid {
+		endDate = s.EndDate.Time.Time.Format(response.MonthLayout)
+	}
+
+	deletedAt := ""
+	if s.DeletedAt.Valid {
+		deletedAt = s.DeletedAt.Time.Format(time.RFC3339)
+	}
+
+	return []string{
+		s.ID,
+		s.UserUUID,
+		strconv.Itoa(s.ServiceID),
+		s.ServiceName,
+		strconv.FormatInt(s.Price, 10),
+		s.Currency,
+		s.StartDate.Format(response.MonthLayout),
+		endDate,
+		s.CreatedAt.Format(time.RFC3339),
+		deletedAt,
+	}
+}
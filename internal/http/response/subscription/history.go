@@ -0,0 +1,37 @@
+package subscription_response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// Audit trail entry with changed fields mapped to their values before and after the change
+type HistoryEntry struct {
+	ID        int             `json:"id"`
+	Operation string          `json:"operation"`
+	Actor     null.String     `json:"actor"`
+	RequestID null.String     `json:"request_id"`
+	Changes   json.RawMessage `json:"changes"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func NewHistory(entries models.SubscriptionAuditSlice) []HistoryEntry {
+
+	result := make([]HistoryEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		result = append(result, HistoryEntry{
+			ID:        entry.ID,
+			Operation: entry.Operation,
+			Actor:     entry.Actor,
+			RequestID: entry.RequestID,
+			Changes:   json.RawMessage(entry.Changes),
+			CreatedAt: entry.CreatedAt,
+		})
+	}
+
+	return result
+}
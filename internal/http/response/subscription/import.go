@@ -0,0 +1,7 @@
+package subscription_response
+
+// Subscription created from the import row
+type ImportedSubscription struct {
+	Row int    `json:"row"`
+	ID  string `json:"id"`
+}
@@ -0,0 +1,37 @@
+package subscription_response
+
+import (
+	"time"
+
+	"github.com/zeleniy/test28/internal/http/response"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+type Price struct {
+	Price         int64          `json:"price"`
+	Currency      string         `json:"currency"`
+	EffectiveFrom response.Month `json:"effective_from"`
+	Current       bool           `json:"current"`
+	Scheduled     bool           `json:"scheduled"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+// Serialize price timeline ordered by effective month, marking the current and scheduled prices
+func NewPrices(prices models.SubscriptionPriceSlice, current *models.SubscriptionPrice) []Price {
+
+	now := time.Now()
+	result := make([]Price, 0, len(prices))
+
+	for _, price := range prices {
+		result = append(result, Price{
+			Price:         price.Price,
+			Currency:      price.Currency,
+			EffectiveFrom: response.Month{Time: price.EffectiveFrom},
+			Current:       price == current,
+			Scheduled:     price.EffectiveFrom.After(now),
+			CreatedAt:     price.CreatedAt,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,44 @@
+package subscription_response
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/zeleniy/test28/internal/http/response"
+)
+
+type ReportSubscription struct {
+	InternalID  int        `boil:"internal_id" json:"-"`
+	ID          string     `boil:"id" json:"id"`
+	ServiceID   int        `boil:"service_id" json:"service_id"`
+	ServiceName string     `boil:"service_name" json:"service_name"`
+	UserUUID    string     `boil:"uuid" json:"user_id"`
+	StartDate   time.Time  `boil:"start_date" json:"start_date"`
+	EndDate     null.Time  `boil:"end_date" json:"end_date"`
+	Months      int        `boil:"-" json:"months"`
+	Sum         null.Int64 `boil:"-" json:"sum"`
+}
+
+// Charges in one of the subscription currencies. Sum is null when any month can not be converted
+type ReportSubtotal struct {
+	Currency string     `json:"currency"`
+	Months   int        `json:"months"`
+	Amount   int64      `json:"amount"`
+	Sum      null.Int64 `json:"sum"`
+}
+
+// Month whose charges can not be converted to the report currency
+type MissingRate struct {
+	Currency string         `json:"currency"`
+	Month    response.Month `json:"month"`
+}
+
+// Report breakdown row, only the keys the report is grouped by are present
+type ReportRow struct {
+	Month       *response.Month `json:"month,omitempty"`
+	ServiceID   *int            `json:"service_id,omitempty"`
+	ServiceName *string         `json:"service_name,omitempty"`
+	UserUUID    *string         `json:"user_id,omitempty"`
+	Count       int             `json:"count"`
+	Sum         null.Int64      `json:"sum"`
+}
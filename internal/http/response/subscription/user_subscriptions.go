@@ -1,10 +1,42 @@
 package subscription_response
 
-import "time"
+import (
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/zeleniy/test28/internal/http/response"
+	"github.com/zeleniy/test28/internal/models"
+	"github.com/zeleniy/test28/internal/pricing"
+)
 
 type UserSubscription struct {
-	ServiceName string    `boil:"service_name" json:"service_name"`
-	Price       int       `boil:"price" json:"price"`
-	UserUUID    string    `boil:"uuid" json:"user_id"`
-	StartDate   time.Time `boil:"start_date" json:"start_date"`
+	ID          string             `json:"id"`
+	ServiceID   int                `json:"service_id"`
+	ServiceName string             `json:"service_name"`
+	Price       int64              `json:"price"`
+	Currency    string             `json:"currency"`
+	UserUUID    string             `json:"user_id"`
+	StartDate   response.Month     `json:"start_date"`
+	EndDate     response.NullMonth `json:"end_date"`
+	CreatedAt   time.Time          `json:"created_at"`
+	DeletedAt   null.Time          `json:"deleted_at"`
+}
+
+// Serialize subscription owned by the user. Price is the one in effect in the current month
+func NewUserSubscription(subscription *models.Subscription, service *models.Service) UserSubscription {
+
+	price := pricing.Current(subscription.R.GetSubscriptionPrices())
+
+	return UserSubscription{
+		ID:          subscription.UUID,
+		ServiceID:   service.ID,
+		ServiceName: service.Name,
+		Price:       price.Price,
+		Currency:    price.Currency,
+		UserUUID:    subscription.UserID,
+		StartDate:   response.Month{Time: subscription.StartDate},
+		EndDate:     response.NullMonth{Time: subscription.EndDate},
+		CreatedAt:   subscription.CreatedAt,
+		DeletedAt:   subscription.DeletedAt,
+	}
 }
@@ -0,0 +1,23 @@
+package user_response
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// User known by UUID, internal ID and password hash are never exposed. Provisioned users have no login
+type User struct {
+	UUID      string      `json:"id"`
+	Login     null.String `json:"login"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+func NewUser(user *models.User) User {
+	return User{
+		UUID:      user.UUID,
+		Login:     user.Login,
+		CreatedAt: user.CreatedAt,
+	}
+}
@@ -0,0 +1,125 @@
+// Responses stored for the Idempotency-Key request header
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// Hash of the request the key is used with. Method and URI are included so that the key can not be reused
+// for another endpoint
+func Hash(method, uri string, body []byte) string {
+
+	hash := sha256.New()
+	hash.Write([]byte(method + " " + uri + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Take the key of the actor for the request in progress unless it is held by another request. Expired key is taken over.
+// Keys are scoped to the actor, so that the same key sent by another user is never seen as used
+func Claim(ctx context.Context, exec boil.ContextExecutor, actor, key, requestHash string, lockTimeout time.Duration) (bool, error) {
+
+	var claimed string
+
+	err := exec.QueryRowContext(ctx, `INSERT INTO idempotency_keys (actor, key, request_hash, expires_at)
+		VALUES ($1, $2, $3, NOW() + make_interval(secs => $4))
+		ON CONFLICT (actor, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status = NULL,
+			response_headers = NULL,
+			response_body = NULL,
+			created_at = NOW(),
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at < NOW()
+		RETURNING key`,
+		actor, key, requestHash, lockTimeout.Seconds(),
+	).Scan(&claimed)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// Find the key of the actor unless it is expired
+func Find(ctx context.Context, exec boil.ContextExecutor, actor, key string) (*models.IdempotencyKey, error) {
+
+	return models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.Actor.EQ(actor),
+		models.IdempotencyKeyWhere.Key.EQ(key),
+		qm.Where("expires_at >= NOW()"),
+	).One(ctx, exec)
+}
+
+// Store response of the request holding the key for replays during the TTL
+func Complete(ctx context.Context, exec boil.ContextExecutor, actor, key string, status int, header http.Header, body []byte, ttl time.Duration) error {
+
+	headers, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	record := models.IdempotencyKey{
+		Actor:           actor,
+		Key:             key,
+		Status:          null.IntFrom(status),
+		ResponseHeaders: null.JSONFrom(headers),
+		ResponseBody:    null.BytesFrom(body),
+		ExpiresAt:       time.Now().Add(ttl),
+	}
+
+	_, err = record.Update(ctx, exec, boil.Whitelist(
+		models.IdempotencyKeyColumns.Status,
+		models.IdempotencyKeyColumns.ResponseHeaders,
+		models.IdempotencyKeyColumns.ResponseBody,
+		models.IdempotencyKeyColumns.ExpiresAt,
+	))
+
+	return err
+}
+
+// Release the key of the request which failed, so that it can be retried
+func Release(ctx context.Context, exec boil.ContextExecutor, actor, key string) error {
+
+	_, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.Actor.EQ(actor),
+		models.IdempotencyKeyWhere.Key.EQ(key),
+		models.IdempotencyKeyWhere.Status.IsNull(),
+	).DeleteAll(ctx, exec)
+
+	return err
+}
+
+// Headers of the stored response
+func Header(record *models.IdempotencyKey) (http.Header, error) {
+
+	var header http.Header
+
+	if !record.ResponseHeaders.Valid {
+		return header, nil
+	}
+
+	err := json.Unmarshal(record.ResponseHeaders.JSON, &header)
+
+	return header, err
+}
+
+// Delete expired keys
+func Purge(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+
+	return models.IdempotencyKeys(qm.Where("expires_at < NOW()")).DeleteAll(ctx, exec)
+}
@@ -0,0 +1,91 @@
+package logging
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// Executor logging every SQL query with its duration, safe for concurrent requests unlike boil.DebugMode
+type QueryLogger struct {
+	exec   boil.ContextExecutor
+	logger *slog.Logger
+}
+
+func NewQueryLogger(exec boil.ContextExecutor, logger *slog.Logger) *QueryLogger {
+	return &QueryLogger{exec: exec, logger: logger}
+}
+
+func (q *QueryLogger) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+
+	start := time.Now()
+	result, err := q.exec.ExecContext(ctx, query, args...)
+	q.log(ctx, query, start, err)
+
+	return result, err
+}
+
+func (q *QueryLogger) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+
+	start := time.Now()
+	rows, err := q.exec.QueryContext(ctx, query, args...)
+	q.log(ctx, query, start, err)
+
+	return rows, err
+}
+
+func (q *QueryLogger) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+
+	start := time.Now()
+	row := q.exec.QueryRowContext(ctx, query, args...)
+	q.log(ctx, query, start, row.Err())
+
+	return row
+}
+
+func (q *QueryLogger) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return q.ExecContext(context.Background(), query, args...)
+}
+
+func (q *QueryLogger) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return q.QueryContext(context.Background(), query, args...)
+}
+
+func (q *QueryLogger) QueryRow(query string, args ...interface{}) *sql.Row {
+	return q.QueryRowContext(context.Background(), query, args...)
+}
+
+// Query arguments are not logged since they may contain personal data
+func (q *QueryLogger) log(ctx context.Context, query string, start time.Time, err error) {
+
+	attrs := []slog.Attr{
+		slog.String("sql", query),
+		slog.Duration("duration", time.Since(start)),
+	}
+
+	if err != nil && err != sql.ErrNoRows {
+		q.logger.LogAttrs(ctx, slog.LevelWarn, "query failed", append(attrs, slog.String("error", err.Error()))...)
+		return
+	}
+
+	q.logger.LogAttrs(ctx, slog.LevelDebug, "query", attrs...)
+}
+
+// Begin transaction on the wrapped executor. Queries of the transaction are logged when it is wrapped as well
+func (q *QueryLogger) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+
+	beginner, ok := q.exec.(boil.ContextBeginner)
+	if !ok {
+		return nil, errors.New("executor does not support transactions")
+	}
+
+	return beginner.BeginTx(ctx, opts)
+}
+
+func (q *QueryLogger) Logger() *slog.Logger {
+	return q.logger
+}
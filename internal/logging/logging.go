@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+type contextKey string
+
+const requestIDKey contextKey = "request_id"
+
+// Create logger writing records in "json" or "text" format with the given minimal level
+func New(w io.Writer, format, level string) (*slog.Logger, error) {
+
+	var slogLevel slog.Level
+
+	if err := slogLevel.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("unknown log level %q", level)
+	}
+
+	options := &slog.HandlerOptions{Level: slogLevel}
+
+	var handler slog.Handler
+
+	switch strings.ToLower(format) {
+	case "json":
+		handler = slog.NewJSONHandler(w, options)
+	case "text":
+		handler = slog.NewTextHandler(w, options)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+
+	return slog.New(contextHandler{handler}), nil
+}
+
+// Store request ID in the context so every record logged with it is correlated
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// Get request ID stored in the context
+func RequestID(ctx context.Context) string {
+
+	requestID, _ := ctx.Value(requestIDKey).(string)
+
+	return requestID
+}
+
+// Handler adding request ID from the context to every record
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+
+	if requestID := RequestID(ctx); requestID != "" {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -8,7 +8,11 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("AuthSessionToUserUsingUser", testAuthSessionToOneUserUsingUser)
+	t.Run("SubscriptionAuditToSubscriptionUsingSubscription", testSubscriptionAuditToOneSubscriptionUsingSubscription)
+	t.Run("SubscriptionPriceToSubscriptionUsingSubscription", testSubscriptionPriceToOneSubscriptionUsingSubscription)
 	t.Run("SubscriptionToUserUsingUser", testSubscriptionToOneUserUsingUser)
+	t.Run("SubscriptionToServiceUsingService", testSubscriptionToOneServiceUsingService)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -18,18 +22,28 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("ServiceToSubscriptions", testServiceToManySubscriptions)
+	t.Run("SubscriptionToSubscriptionAudits", testSubscriptionToManySubscriptionAudits)
+	t.Run("SubscriptionToSubscriptionPrices", testSubscriptionToManySubscriptionPrices)
+	t.Run("UserToAuthSessions", testUserToManyAuthSessions)
 	t.Run("UserToSubscriptions", testUserToManySubscriptions)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("AuthSessionToUserUsingAuthSessions", testAuthSessionToOneSetOpUserUsingUser)
+	t.Run("SubscriptionAuditToSubscriptionUsingSubscriptionAudits", testSubscriptionAuditToOneSetOpSubscriptionUsingSubscription)
+	t.Run("SubscriptionPriceToSubscriptionUsingSubscriptionPrices", testSubscriptionPriceToOneSetOpSubscriptionUsingSubscription)
 	t.Run("SubscriptionToUserUsingSubscriptions", testSubscriptionToOneSetOpUserUsingUser)
+	t.Run("SubscriptionToServiceUsingSubscriptions", testSubscriptionToOneSetOpServiceUsingService)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("SubscriptionAuditToSubscriptionUsingSubscriptionAudits", testSubscriptionAuditToOneRemoveOpSubscriptionUsingSubscription)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -42,13 +56,21 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("ServiceToSubscriptions", testServiceToManyAddOpSubscriptions)
+	t.Run("SubscriptionToSubscriptionAudits", testSubscriptionToManyAddOpSubscriptionAudits)
+	t.Run("SubscriptionToSubscriptionPrices", testSubscriptionToManyAddOpSubscriptionPrices)
+	t.Run("UserToAuthSessions", testUserToManyAddOpAuthSessions)
 	t.Run("UserToSubscriptions", testUserToManyAddOpSubscriptions)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("SubscriptionToSubscriptionAudits", testSubscriptionToManySetOpSubscriptionAudits)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("SubscriptionToSubscriptionAudits", testSubscriptionToManyRemoveOpSubscriptionAudits)
+}
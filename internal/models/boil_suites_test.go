@@ -12,61 +12,151 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessions)
+	t.Run("ExchangeRates", testExchangeRates)
+	t.Run("IdempotencyKeys", testIdempotencyKeys)
+	t.Run("Services", testServices)
+	t.Run("SubscriptionAudits", testSubscriptionAudits)
+	t.Run("SubscriptionPrices", testSubscriptionPrices)
 	t.Run("Subscriptions", testSubscriptions)
 	t.Run("Users", testUsers)
 }
 
+func TestSoftDelete(t *testing.T) {
+	t.Run("Subscriptions", testSubscriptionsSoftDelete)
+}
+
+func TestQuerySoftDeleteAll(t *testing.T) {
+	t.Run("Subscriptions", testSubscriptionsQuerySoftDeleteAll)
+}
+
+func TestSliceSoftDeleteAll(t *testing.T) {
+	t.Run("Subscriptions", testSubscriptionsSliceSoftDeleteAll)
+}
+
 func TestDelete(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsDelete)
+	t.Run("ExchangeRates", testExchangeRatesDelete)
+	t.Run("IdempotencyKeys", testIdempotencyKeysDelete)
+	t.Run("Services", testServicesDelete)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsDelete)
+	t.Run("SubscriptionPrices", testSubscriptionPricesDelete)
 	t.Run("Subscriptions", testSubscriptionsDelete)
 	t.Run("Users", testUsersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsQueryDeleteAll)
+	t.Run("ExchangeRates", testExchangeRatesQueryDeleteAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysQueryDeleteAll)
+	t.Run("Services", testServicesQueryDeleteAll)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsQueryDeleteAll)
+	t.Run("SubscriptionPrices", testSubscriptionPricesQueryDeleteAll)
 	t.Run("Subscriptions", testSubscriptionsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsSliceDeleteAll)
+	t.Run("ExchangeRates", testExchangeRatesSliceDeleteAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSliceDeleteAll)
+	t.Run("Services", testServicesSliceDeleteAll)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsSliceDeleteAll)
+	t.Run("SubscriptionPrices", testSubscriptionPricesSliceDeleteAll)
 	t.Run("Subscriptions", testSubscriptionsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsExists)
+	t.Run("ExchangeRates", testExchangeRatesExists)
+	t.Run("IdempotencyKeys", testIdempotencyKeysExists)
+	t.Run("Services", testServicesExists)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsExists)
+	t.Run("SubscriptionPrices", testSubscriptionPricesExists)
 	t.Run("Subscriptions", testSubscriptionsExists)
 	t.Run("Users", testUsersExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsFind)
+	t.Run("ExchangeRates", testExchangeRatesFind)
+	t.Run("IdempotencyKeys", testIdempotencyKeysFind)
+	t.Run("Services", testServicesFind)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsFind)
+	t.Run("SubscriptionPrices", testSubscriptionPricesFind)
 	t.Run("Subscriptions", testSubscriptionsFind)
 	t.Run("Users", testUsersFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsBind)
+	t.Run("ExchangeRates", testExchangeRatesBind)
+	t.Run("IdempotencyKeys", testIdempotencyKeysBind)
+	t.Run("Services", testServicesBind)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsBind)
+	t.Run("SubscriptionPrices", testSubscriptionPricesBind)
 	t.Run("Subscriptions", testSubscriptionsBind)
 	t.Run("Users", testUsersBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsOne)
+	t.Run("ExchangeRates", testExchangeRatesOne)
+	t.Run("IdempotencyKeys", testIdempotencyKeysOne)
+	t.Run("Services", testServicesOne)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsOne)
+	t.Run("SubscriptionPrices", testSubscriptionPricesOne)
 	t.Run("Subscriptions", testSubscriptionsOne)
 	t.Run("Users", testUsersOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsAll)
+	t.Run("ExchangeRates", testExchangeRatesAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysAll)
+	t.Run("Services", testServicesAll)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsAll)
+	t.Run("SubscriptionPrices", testSubscriptionPricesAll)
 	t.Run("Subscriptions", testSubscriptionsAll)
 	t.Run("Users", testUsersAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsCount)
+	t.Run("ExchangeRates", testExchangeRatesCount)
+	t.Run("IdempotencyKeys", testIdempotencyKeysCount)
+	t.Run("Services", testServicesCount)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsCount)
+	t.Run("SubscriptionPrices", testSubscriptionPricesCount)
 	t.Run("Subscriptions", testSubscriptionsCount)
 	t.Run("Users", testUsersCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsHooks)
+	t.Run("ExchangeRates", testExchangeRatesHooks)
+	t.Run("IdempotencyKeys", testIdempotencyKeysHooks)
+	t.Run("Services", testServicesHooks)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsHooks)
+	t.Run("SubscriptionPrices", testSubscriptionPricesHooks)
 	t.Run("Subscriptions", testSubscriptionsHooks)
 	t.Run("Users", testUsersHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsInsert)
+	t.Run("AuthSessions", testAuthSessionsInsertWhitelist)
+	t.Run("ExchangeRates", testExchangeRatesInsert)
+	t.Run("ExchangeRates", testExchangeRatesInsertWhitelist)
+	t.Run("IdempotencyKeys", testIdempotencyKeysInsert)
+	t.Run("IdempotencyKeys", testIdempotencyKeysInsertWhitelist)
+	t.Run("Services", testServicesInsert)
+	t.Run("Services", testServicesInsertWhitelist)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsInsert)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsInsertWhitelist)
+	t.Run("SubscriptionPrices", testSubscriptionPricesInsert)
+	t.Run("SubscriptionPrices", testSubscriptionPricesInsertWhitelist)
 	t.Run("Subscriptions", testSubscriptionsInsert)
 	t.Run("Subscriptions", testSubscriptionsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
@@ -74,26 +164,56 @@ func TestInsert(t *testing.T) {
 }
 
 func TestReload(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsReload)
+	t.Run("ExchangeRates", testExchangeRatesReload)
+	t.Run("IdempotencyKeys", testIdempotencyKeysReload)
+	t.Run("Services", testServicesReload)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsReload)
+	t.Run("SubscriptionPrices", testSubscriptionPricesReload)
 	t.Run("Subscriptions", testSubscriptionsReload)
 	t.Run("Users", testUsersReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsReloadAll)
+	t.Run("ExchangeRates", testExchangeRatesReloadAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysReloadAll)
+	t.Run("Services", testServicesReloadAll)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsReloadAll)
+	t.Run("SubscriptionPrices", testSubscriptionPricesReloadAll)
 	t.Run("Subscriptions", testSubscriptionsReloadAll)
 	t.Run("Users", testUsersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsSelect)
+	t.Run("ExchangeRates", testExchangeRatesSelect)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSelect)
+	t.Run("Services", testServicesSelect)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsSelect)
+	t.Run("SubscriptionPrices", testSubscriptionPricesSelect)
 	t.Run("Subscriptions", testSubscriptionsSelect)
 	t.Run("Users", testUsersSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsUpdate)
+	t.Run("ExchangeRates", testExchangeRatesUpdate)
+	t.Run("IdempotencyKeys", testIdempotencyKeysUpdate)
+	t.Run("Services", testServicesUpdate)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsUpdate)
+	t.Run("SubscriptionPrices", testSubscriptionPricesUpdate)
 	t.Run("Subscriptions", testSubscriptionsUpdate)
 	t.Run("Users", testUsersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsSliceUpdateAll)
+	t.Run("ExchangeRates", testExchangeRatesSliceUpdateAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSliceUpdateAll)
+	t.Run("Services", testServicesSliceUpdateAll)
+	t.Run("SubscriptionAudits", testSubscriptionAuditsSliceUpdateAll)
+	t.Run("SubscriptionPrices", testSubscriptionPricesSliceUpdateAll)
 	t.Run("Subscriptions", testSubscriptionsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
 }
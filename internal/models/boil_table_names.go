@@ -4,9 +4,21 @@
 package models
 
 var TableNames = struct {
-	Subscriptions string
-	Users         string
+	AuthSessions       string
+	ExchangeRates      string
+	IdempotencyKeys    string
+	Services           string
+	SubscriptionAudits string
+	SubscriptionPrices string
+	Subscriptions      string
+	Users              string
 }{
-	Subscriptions: "subscriptions",
-	Users:         "users",
+	AuthSessions:       "auth_sessions",
+	ExchangeRates:      "exchange_rates",
+	IdempotencyKeys:    "idempotency_keys",
+	Services:           "services",
+	SubscriptionAudits: "subscription_audits",
+	SubscriptionPrices: "subscription_prices",
+	Subscriptions:      "subscriptions",
+	Users:              "users",
 }
@@ -6,6 +6,18 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("AuthSessions", testAuthSessionsUpsert)
+
+	t.Run("ExchangeRates", testExchangeRatesUpsert)
+
+	t.Run("IdempotencyKeys", testIdempotencyKeysUpsert)
+
+	t.Run("Services", testServicesUpsert)
+
+	t.Run("SubscriptionAudits", testSubscriptionAuditsUpsert)
+
+	t.Run("SubscriptionPrices", testSubscriptionPricesUpsert)
+
 	t.Run("Subscriptions", testSubscriptionsUpsert)
 
 	t.Run("Users", testUsersUpsert)
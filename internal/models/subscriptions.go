@@ -25,187 +25,113 @@ import (
 // Subscription is an object representing the database table.
 type Subscription struct {
 	ID int `boil:"id" json:"id" toml:"id" yaml:"id"`
-	// Reference to users.id
-	UserID int `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	// Subscribed service
-	ServiceName string `boil:"service_name" json:"service_name" toml:"service_name" yaml:"service_name"`
-	// Subscription price
-	Price int `boil:"price" json:"price" toml:"price" yaml:"price"`
+	// Reference to users.uuid
+	UserID string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
 	// Subscription start date
 	StartDate time.Time `boil:"start_date" json:"start_date" toml:"start_date" yaml:"start_date"`
 	// Subscription end date
 	EndDate   null.Time `boil:"end_date" json:"end_date,omitempty" toml:"end_date" yaml:"end_date,omitempty"`
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	// Reference to services.id
+	ServiceID int `boil:"service_id" json:"service_id" toml:"service_id" yaml:"service_id"`
+	// Date soft deleted
+	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// Incremented on every change, exposed as ETag
+	Version int `boil:"version" json:"version" toml:"version" yaml:"version"`
+	// Public identifier
+	UUID string `boil:"uuid" json:"uuid" toml:"uuid" yaml:"uuid"`
 
 	R *subscriptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L subscriptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var SubscriptionColumns = struct {
-	ID          string
-	UserID      string
-	ServiceName string
-	Price       string
-	StartDate   string
-	EndDate     string
-	CreatedAt   string
+	ID        string
+	UserID    string
+	StartDate string
+	EndDate   string
+	CreatedAt string
+	ServiceID string
+	DeletedAt string
+	Version   string
+	UUID      string
 }{
-	ID:          "id",
-	UserID:      "user_id",
-	ServiceName: "service_name",
-	Price:       "price",
-	StartDate:   "start_date",
-	EndDate:     "end_date",
-	CreatedAt:   "created_at",
+	ID:        "id",
+	UserID:    "user_id",
+	StartDate: "start_date",
+	EndDate:   "end_date",
+	CreatedAt: "created_at",
+	ServiceID: "service_id",
+	DeletedAt: "deleted_at",
+	Version:   "version",
+	UUID:      "uuid",
 }
 
 var SubscriptionTableColumns = struct {
-	ID          string
-	UserID      string
-	ServiceName string
-	Price       string
-	StartDate   string
-	EndDate     string
-	CreatedAt   string
+	ID        string
+	UserID    string
+	StartDate string
+	EndDate   string
+	CreatedAt string
+	ServiceID string
+	DeletedAt string
+	Version   string
+	UUID      string
 }{
-	ID:          "subscriptions.id",
-	UserID:      "subscriptions.user_id",
-	ServiceName: "subscriptions.service_name",
-	Price:       "subscriptions.price",
-	StartDate:   "subscriptions.start_date",
-	EndDate:     "subscriptions.end_date",
-	CreatedAt:   "subscriptions.created_at",
+	ID:        "subscriptions.id",
+	UserID:    "subscriptions.user_id",
+	StartDate: "subscriptions.start_date",
+	EndDate:   "subscriptions.end_date",
+	CreatedAt: "subscriptions.created_at",
+	ServiceID: "subscriptions.service_id",
+	DeletedAt: "subscriptions.deleted_at",
+	Version:   "subscriptions.version",
+	UUID:      "subscriptions.uuid",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod    { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod   { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod   { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) SIMILAR(x string) qm.QueryMod { return qm.Where(w.field+" SIMILAR TO ?", x) }
-func (w whereHelperstring) NSIMILAR(x string) qm.QueryMod {
-	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
-}
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var SubscriptionWhere = struct {
-	ID          whereHelperint
-	UserID      whereHelperint
-	ServiceName whereHelperstring
-	Price       whereHelperint
-	StartDate   whereHelpertime_Time
-	EndDate     whereHelpernull_Time
-	CreatedAt   whereHelpertime_Time
+	ID        whereHelperint
+	UserID    whereHelperstring
+	StartDate whereHelpertime_Time
+	EndDate   whereHelpernull_Time
+	CreatedAt whereHelpertime_Time
+	ServiceID whereHelperint
+	DeletedAt whereHelpernull_Time
+	Version   whereHelperint
+	UUID      whereHelperstring
 }{
-	ID:          whereHelperint{field: "\"subscriptions\".\"id\""},
-	UserID:      whereHelperint{field: "\"subscriptions\".\"user_id\""},
-	ServiceName: whereHelperstring{field: "\"subscriptions\".\"service_name\""},
-	Price:       whereHelperint{field: "\"subscriptions\".\"price\""},
-	StartDate:   whereHelpertime_Time{field: "\"subscriptions\".\"start_date\""},
-	EndDate:     whereHelpernull_Time{field: "\"subscriptions\".\"end_date\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"subscriptions\".\"created_at\""},
+	ID:        whereHelperint{field: "\"subscriptions\".\"id\""},
+	UserID:    whereHelperstring{field: "\"subscriptions\".\"user_id\""},
+	StartDate: whereHelpertime_Time{field: "\"subscriptions\".\"start_date\""},
+	EndDate:   whereHelpernull_Time{field: "\"subscriptions\".\"end_date\""},
+	CreatedAt: whereHelpertime_Time{field: "\"subscriptions\".\"created_at\""},
+	ServiceID: whereHelperint{field: "\"subscriptions\".\"service_id\""},
+	DeletedAt: whereHelpernull_Time{field: "\"subscriptions\".\"deleted_at\""},
+	Version:   whereHelperint{field: "\"subscriptions\".\"version\""},
+	UUID:      whereHelperstring{field: "\"subscriptions\".\"uuid\""},
 }
 
 // SubscriptionRels is where relationship names are stored.
 var SubscriptionRels = struct {
-	User string
+	User               string
+	Service            string
+	SubscriptionAudits string
+	SubscriptionPrices string
 }{
-	User: "User",
+	User:               "User",
+	Service:            "Service",
+	SubscriptionAudits: "SubscriptionAudits",
+	SubscriptionPrices: "SubscriptionPrices",
 }
 
 // subscriptionR is where relationships are stored.
 type subscriptionR struct {
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	User               *User                  `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Service            *Service               `boil:"Service" json:"Service" toml:"Service" yaml:"Service"`
+	SubscriptionAudits SubscriptionAuditSlice `boil:"SubscriptionAudits" json:"SubscriptionAudits" toml:"SubscriptionAudits" yaml:"SubscriptionAudits"`
+	SubscriptionPrices SubscriptionPriceSlice `boil:"SubscriptionPrices" json:"SubscriptionPrices" toml:"SubscriptionPrices" yaml:"SubscriptionPrices"`
 }
 
 // NewStruct creates a new relationship struct
@@ -229,13 +155,61 @@ func (r *subscriptionR) GetUser() *User {
 	return r.User
 }
 
+func (o *Subscription) GetService() *Service {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetService()
+}
+
+func (r *subscriptionR) GetService() *Service {
+	if r == nil {
+		return nil
+	}
+
+	return r.Service
+}
+
+func (o *Subscription) GetSubscriptionAudits() SubscriptionAuditSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSubscriptionAudits()
+}
+
+func (r *subscriptionR) GetSubscriptionAudits() SubscriptionAuditSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SubscriptionAudits
+}
+
+func (o *Subscription) GetSubscriptionPrices() SubscriptionPriceSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSubscriptionPrices()
+}
+
+func (r *subscriptionR) GetSubscriptionPrices() SubscriptionPriceSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.SubscriptionPrices
+}
+
 // subscriptionL is where Load methods for each relationship are stored.
 type subscriptionL struct{}
 
 var (
-	subscriptionAllColumns            = []string{"id", "user_id", "service_name", "price", "start_date", "end_date", "created_at"}
-	subscriptionColumnsWithoutDefault = []string{"user_id", "service_name", "price", "start_date"}
-	subscriptionColumnsWithDefault    = []string{"id", "end_date", "created_at"}
+	subscriptionAllColumns            = []string{"id", "user_id", "start_date", "end_date", "created_at", "service_id", "deleted_at", "version", "uuid"}
+	subscriptionColumnsWithoutDefault = []string{"user_id", "start_date", "service_id"}
+	subscriptionColumnsWithDefault    = []string{"id", "end_date", "created_at", "deleted_at", "version", "uuid"}
 	subscriptionPrimaryKeyColumns     = []string{"id"}
 	subscriptionGeneratedColumns      = []string{}
 )
@@ -548,7 +522,7 @@ func (q subscriptionQuery) Exists(ctx context.Context, exec boil.ContextExecutor
 // User pointed to by the foreign key.
 func (o *Subscription) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
-		qm.Where("\"id\" = ?", o.UserID),
+		qm.Where("\"uuid\" = ?", o.UserID),
 	}
 
 	queryMods = append(queryMods, mods...)
@@ -556,6 +530,45 @@ func (o *Subscription) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// Service pointed to by the foreign key.
+func (o *Subscription) Service(mods ...qm.QueryMod) serviceQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServiceID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Services(queryMods...)
+}
+
+// SubscriptionAudits retrieves all the subscription_audit's SubscriptionAudits with an executor.
+func (o *Subscription) SubscriptionAudits(mods ...qm.QueryMod) subscriptionAuditQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"subscription_audits\".\"subscription_id\"=?", o.ID),
+	)
+
+	return SubscriptionAudits(queryMods...)
+}
+
+// SubscriptionPrices retrieves all the subscription_price's SubscriptionPrices with an executor.
+func (o *Subscription) SubscriptionPrices(mods ...qm.QueryMod) subscriptionPriceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"subscription_prices\".\"subscription_id\"=?", o.ID),
+	)
+
+	return SubscriptionPrices(queryMods...)
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (subscriptionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubscription interface{}, mods queries.Applicator) error {
@@ -615,7 +628,7 @@ func (subscriptionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singu
 
 	query := NewQuery(
 		qm.From(`users`),
-		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qm.WhereIn(`users.uuid in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -662,7 +675,7 @@ func (subscriptionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singu
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.UserID == foreign.ID {
+			if local.UserID == foreign.UUID {
 				local.R.User = foreign
 				if foreign.R == nil {
 					foreign.R = &userR{}
@@ -676,6 +689,352 @@ func (subscriptionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
+// LoadService allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (subscriptionL) LoadService(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubscription interface{}, mods queries.Applicator) error {
+	var slice []*Subscription
+	var object *Subscription
+
+	if singular {
+		var ok bool
+		object, ok = maybeSubscription.(*Subscription)
+		if !ok {
+			object = new(Subscription)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubscription))
+			}
+		}
+	} else {
+		s, ok := maybeSubscription.(*[]*Subscription)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubscription))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &subscriptionR{}
+		}
+		args[object.ServiceID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subscriptionR{}
+			}
+
+			args[obj.ServiceID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`services`),
+		qm.WhereIn(`services.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Service")
+	}
+
+	var resultSlice []*Service
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Service")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for services")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for services")
+	}
+
+	if len(serviceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Service = foreign
+		if foreign.R == nil {
+			foreign.R = &serviceR{}
+		}
+		foreign.R.Subscriptions = append(foreign.R.Subscriptions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ServiceID == foreign.ID {
+				local.R.Service = foreign
+				if foreign.R == nil {
+					foreign.R = &serviceR{}
+				}
+				foreign.R.Subscriptions = append(foreign.R.Subscriptions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSubscriptionAudits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (subscriptionL) LoadSubscriptionAudits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubscription interface{}, mods queries.Applicator) error {
+	var slice []*Subscription
+	var object *Subscription
+
+	if singular {
+		var ok bool
+		object, ok = maybeSubscription.(*Subscription)
+		if !ok {
+			object = new(Subscription)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubscription))
+			}
+		}
+	} else {
+		s, ok := maybeSubscription.(*[]*Subscription)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubscription))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &subscriptionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subscriptionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`subscription_audits`),
+		qm.WhereIn(`subscription_audits.subscription_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load subscription_audits")
+	}
+
+	var resultSlice []*SubscriptionAudit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice subscription_audits")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on subscription_audits")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for subscription_audits")
+	}
+
+	if len(subscriptionAuditAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SubscriptionAudits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &subscriptionAuditR{}
+			}
+			foreign.R.Subscription = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SubscriptionID) {
+				local.R.SubscriptionAudits = append(local.R.SubscriptionAudits, foreign)
+				if foreign.R == nil {
+					foreign.R = &subscriptionAuditR{}
+				}
+				foreign.R.Subscription = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSubscriptionPrices allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (subscriptionL) LoadSubscriptionPrices(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubscription interface{}, mods queries.Applicator) error {
+	var slice []*Subscription
+	var object *Subscription
+
+	if singular {
+		var ok bool
+		object, ok = maybeSubscription.(*Subscription)
+		if !ok {
+			object = new(Subscription)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubscription))
+			}
+		}
+	} else {
+		s, ok := maybeSubscription.(*[]*Subscription)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubscription))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &subscriptionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subscriptionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`subscription_prices`),
+		qm.WhereIn(`subscription_prices.subscription_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load subscription_prices")
+	}
+
+	var resultSlice []*SubscriptionPrice
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice subscription_prices")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on subscription_prices")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for subscription_prices")
+	}
+
+	if len(subscriptionPriceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SubscriptionPrices = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &subscriptionPriceR{}
+			}
+			foreign.R.Subscription = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SubscriptionID {
+				local.R.SubscriptionPrices = append(local.R.SubscriptionPrices, foreign)
+				if foreign.R == nil {
+					foreign.R = &subscriptionPriceR{}
+				}
+				foreign.R.Subscription = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetUser of the subscription to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.Subscriptions.
@@ -692,7 +1051,7 @@ func (o *Subscription) SetUser(ctx context.Context, exec boil.ContextExecutor, i
 		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
 		strmangle.WhereClause("\"", "\"", 2, subscriptionPrimaryKeyColumns),
 	)
-	values := []interface{}{related.ID, o.ID}
+	values := []interface{}{related.UUID, o.ID}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -703,7 +1062,7 @@ func (o *Subscription) SetUser(ctx context.Context, exec boil.ContextExecutor, i
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	o.UserID = related.ID
+	o.UserID = related.UUID
 	if o.R == nil {
 		o.R = &subscriptionR{
 			User: related,
@@ -723,9 +1082,236 @@ func (o *Subscription) SetUser(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
+// SetService of the subscription to the related item.
+// Sets o.R.Service to related.
+// Adds o to related.R.Subscriptions.
+func (o *Subscription) SetService(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Service) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"subscriptions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"service_id"}),
+		strmangle.WhereClause("\"", "\"", 2, subscriptionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ServiceID = related.ID
+	if o.R == nil {
+		o.R = &subscriptionR{
+			Service: related,
+		}
+	} else {
+		o.R.Service = related
+	}
+
+	if related.R == nil {
+		related.R = &serviceR{
+			Subscriptions: SubscriptionSlice{o},
+		}
+	} else {
+		related.R.Subscriptions = append(related.R.Subscriptions, o)
+	}
+
+	return nil
+}
+
+// AddSubscriptionAudits adds the given related objects to the existing relationships
+// of the subscription, optionally inserting them as new records.
+// Appends related to o.R.SubscriptionAudits.
+// Sets related.R.Subscription appropriately.
+func (o *Subscription) AddSubscriptionAudits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubscriptionAudit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SubscriptionID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"subscription_audits\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subscription_id"}),
+				strmangle.WhereClause("\"", "\"", 2, subscriptionAuditPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SubscriptionID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &subscriptionR{
+			SubscriptionAudits: related,
+		}
+	} else {
+		o.R.SubscriptionAudits = append(o.R.SubscriptionAudits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &subscriptionAuditR{
+				Subscription: o,
+			}
+		} else {
+			rel.R.Subscription = o
+		}
+	}
+	return nil
+}
+
+// SetSubscriptionAudits removes all previously related items of the
+// subscription replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Subscription's SubscriptionAudits accordingly.
+// Replaces o.R.SubscriptionAudits with related.
+// Sets related.R.Subscription's SubscriptionAudits accordingly.
+func (o *Subscription) SetSubscriptionAudits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubscriptionAudit) error {
+	query := "update \"subscription_audits\" set \"subscription_id\" = null where \"subscription_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SubscriptionAudits {
+			queries.SetScanner(&rel.SubscriptionID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Subscription = nil
+		}
+		o.R.SubscriptionAudits = nil
+	}
+
+	return o.AddSubscriptionAudits(ctx, exec, insert, related...)
+}
+
+// RemoveSubscriptionAudits relationships from objects passed in.
+// Removes related items from R.SubscriptionAudits (uses pointer comparison, removal does not keep order)
+// Sets related.R.Subscription.
+func (o *Subscription) RemoveSubscriptionAudits(ctx context.Context, exec boil.ContextExecutor, related ...*SubscriptionAudit) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SubscriptionID, nil)
+		if rel.R != nil {
+			rel.R.Subscription = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("subscription_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SubscriptionAudits {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SubscriptionAudits)
+			if ln > 1 && i < ln-1 {
+				o.R.SubscriptionAudits[i] = o.R.SubscriptionAudits[ln-1]
+			}
+			o.R.SubscriptionAudits = o.R.SubscriptionAudits[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSubscriptionPrices adds the given related objects to the existing relationships
+// of the subscription, optionally inserting them as new records.
+// Appends related to o.R.SubscriptionPrices.
+// Sets related.R.Subscription appropriately.
+func (o *Subscription) AddSubscriptionPrices(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SubscriptionPrice) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SubscriptionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"subscription_prices\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subscription_id"}),
+				strmangle.WhereClause("\"", "\"", 2, subscriptionPricePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SubscriptionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &subscriptionR{
+			SubscriptionPrices: related,
+		}
+	} else {
+		o.R.SubscriptionPrices = append(o.R.SubscriptionPrices, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &subscriptionPriceR{
+				Subscription: o,
+			}
+		} else {
+			rel.R.Subscription = o
+		}
+	}
+	return nil
+}
+
 // Subscriptions retrieves all the records using an executor.
 func Subscriptions(mods ...qm.QueryMod) subscriptionQuery {
-	mods = append(mods, qm.From("\"subscriptions\""))
+	mods = append(mods, qm.From("\"subscriptions\""), qmhelper.WhereIsNull("\"subscriptions\".\"deleted_at\""))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"\"subscriptions\".*"})
@@ -744,7 +1330,7 @@ func FindSubscription(ctx context.Context, exec boil.ContextExecutor, iD int, se
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"subscriptions\" where \"id\"=$1", sel,
+		"select %s from \"subscriptions\" where \"id\"=$1 and \"deleted_at\" is null", sel,
 	)
 
 	q := queries.Raw(query, iD)
@@ -1109,7 +1695,7 @@ func (o *Subscription) Upsert(ctx context.Context, exec boil.ContextExecutor, up
 
 // Delete deletes a single Subscription record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *Subscription) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o *Subscription) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("models: no Subscription provided for delete")
 	}
@@ -1118,8 +1704,26 @@ func (o *Subscription) Delete(ctx context.Context, exec boil.ContextExecutor) (i
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), subscriptionPrimaryKeyMapping)
-	sql := "DELETE FROM \"subscriptions\" WHERE \"id\"=$1"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), subscriptionPrimaryKeyMapping)
+		sql = "DELETE FROM \"subscriptions\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"subscriptions\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(subscriptionType, subscriptionMapping, append(wl, subscriptionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1144,12 +1748,17 @@ func (o *Subscription) Delete(ctx context.Context, exec boil.ContextExecutor) (i
 }
 
 // DeleteAll deletes all matching rows.
-func (q subscriptionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q subscriptionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("models: no subscriptionQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1165,7 +1774,7 @@ func (q subscriptionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecu
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o SubscriptionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o SubscriptionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -1178,15 +1787,32 @@ func (o SubscriptionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecu
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriptionPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriptionPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"subscriptions\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subscriptionPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subscriptionPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"subscriptions\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, subscriptionPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM \"subscriptions\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subscriptionPrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1240,7 +1866,8 @@ func (o *SubscriptionSlice) ReloadAll(ctx context.Context, exec boil.ContextExec
 	}
 
 	sql := "SELECT \"subscriptions\".* FROM \"subscriptions\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subscriptionPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subscriptionPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1257,7 +1884,7 @@ func (o *SubscriptionSlice) ReloadAll(ctx context.Context, exec boil.ContextExec
 // SubscriptionExists checks if the Subscription row exists.
 func SubscriptionExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"subscriptions\" where \"id\"=$1 limit 1)"
+	sql := "select exists(select 1 from \"subscriptions\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
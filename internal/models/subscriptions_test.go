@@ -31,6 +31,107 @@ func testSubscriptions(t *testing.T) {
 	}
 }
 
+func testSubscriptionsSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscription{}
+	if err = randomize.Struct(seed, o, subscriptionDBTypes, true, subscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Subscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSubscriptionsQuerySoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscription{}
+	if err = randomize.Struct(seed, o, subscriptionDBTypes, true, subscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Subscriptions().DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Subscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSubscriptionsSliceSoftDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Subscription{}
+	if err = randomize.Struct(seed, o, subscriptionDBTypes, true, subscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SubscriptionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx, false); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Subscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
 func testSubscriptionsDelete(t *testing.T) {
 	t.Parallel()
 
@@ -48,7 +149,7 @@ func testSubscriptionsDelete(t *testing.T) {
 		t.Error(err)
 	}
 
-	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+	if rowsAff, err := o.Delete(ctx, tx, true); err != nil {
 		t.Error(err)
 	} else if rowsAff != 1 {
 		t.Error("should only have deleted one row, but affected:", rowsAff)
@@ -81,7 +182,7 @@ func testSubscriptionsQueryDeleteAll(t *testing.T) {
 		t.Error(err)
 	}
 
-	if rowsAff, err := Subscriptions().DeleteAll(ctx, tx); err != nil {
+	if rowsAff, err := Subscriptions().DeleteAll(ctx, tx, true); err != nil {
 		t.Error(err)
 	} else if rowsAff != 1 {
 		t.Error("should only have deleted one row, but affected:", rowsAff)
@@ -116,7 +217,7 @@ func testSubscriptionsSliceDeleteAll(t *testing.T) {
 
 	slice := SubscriptionSlice{o}
 
-	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+	if rowsAff, err := slice.DeleteAll(ctx, tx, true); err != nil {
 		t.Error(err)
 	} else if rowsAff != 1 {
 		t.Error("should only have deleted one row, but affected:", rowsAff)
@@ -494,6 +595,487 @@ func testSubscriptionsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testSubscriptionToManySubscriptionAudits(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Subscription
+	var b, c SubscriptionAudit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, subscriptionDBTypes, true, subscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscription struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, subscriptionAuditDBTypes, false, subscriptionAuditColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, subscriptionAuditDBTypes, false, subscriptionAuditColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SubscriptionID, a.ID)
+	queries.Assign(&c.SubscriptionID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SubscriptionAudits().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SubscriptionID, b.SubscriptionID) {
+			bFound = true
+		}
+		if queries.Equal(v.SubscriptionID, c.SubscriptionID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SubscriptionSlice{&a}
+	if err = a.L.LoadSubscriptionAudits(ctx, tx, false, (*[]*Subscription)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SubscriptionAudits); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SubscriptionAudits = nil
+	if err = a.L.LoadSubscriptionAudits(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SubscriptionAudits); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSubscriptionToManySubscriptionPrices(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Subscription
+	var b, c SubscriptionPrice
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, subscriptionDBTypes, true, subscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscription struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, subscriptionPriceDBTypes, false, subscriptionPriceColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, subscriptionPriceDBTypes, false, subscriptionPriceColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SubscriptionID = a.ID
+	c.SubscriptionID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SubscriptionPrices().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SubscriptionID == b.SubscriptionID {
+			bFound = true
+		}
+		if v.SubscriptionID == c.SubscriptionID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SubscriptionSlice{&a}
+	if err = a.L.LoadSubscriptionPrices(ctx, tx, false, (*[]*Subscription)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SubscriptionPrices); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SubscriptionPrices = nil
+	if err = a.L.LoadSubscriptionPrices(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SubscriptionPrices); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSubscriptionToManyAddOpSubscriptionAudits(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Subscription
+	var b, c, d, e SubscriptionAudit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, subscriptionDBTypes, false, strmangle.SetComplement(subscriptionPrimaryKeyColumns, subscriptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*SubscriptionAudit{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, subscriptionAuditDBTypes, false, strmangle.SetComplement(subscriptionAuditPrimaryKeyColumns, subscriptionAuditColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*SubscriptionAudit{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSubscriptionAudits(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SubscriptionID) {
+			t.Error("foreign key was wrong value", a.ID, first.SubscriptionID)
+		}
+		if !queries.Equal(a.ID, second.SubscriptionID) {
+			t.Error("foreign key was wrong value", a.ID, second.SubscriptionID)
+		}
+
+		if first.R.Subscription != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Subscription != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SubscriptionAudits[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SubscriptionAudits[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SubscriptionAudits().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSubscriptionToManySetOpSubscriptionAudits(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Subscription
+	var b, c, d, e SubscriptionAudit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, subscriptionDBTypes, false, strmangle.SetComplement(subscriptionPrimaryKeyColumns, subscriptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*SubscriptionAudit{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, subscriptionAuditDBTypes, false, strmangle.SetComplement(subscriptionAuditPrimaryKeyColumns, subscriptionAuditColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSubscriptionAudits(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SubscriptionAudits().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSubscriptionAudits(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SubscriptionAudits().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SubscriptionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SubscriptionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SubscriptionID) {
+		t.Error("foreign key was wrong value", a.ID, d.SubscriptionID)
+	}
+	if !queries.Equal(a.ID, e.SubscriptionID) {
+		t.Error("foreign key was wrong value", a.ID, e.SubscriptionID)
+	}
+
+	if b.R.Subscription != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Subscription != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Subscription != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Subscription != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SubscriptionAudits[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SubscriptionAudits[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testSubscriptionToManyRemoveOpSubscriptionAudits(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Subscription
+	var b, c, d, e SubscriptionAudit
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, subscriptionDBTypes, false, strmangle.SetComplement(subscriptionPrimaryKeyColumns, subscriptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*SubscriptionAudit{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, subscriptionAuditDBTypes, false, strmangle.SetComplement(subscriptionAuditPrimaryKeyColumns, subscriptionAuditColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSubscriptionAudits(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SubscriptionAudits().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSubscriptionAudits(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SubscriptionAudits().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SubscriptionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SubscriptionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Subscription != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Subscription != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Subscription != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Subscription != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SubscriptionAudits) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SubscriptionAudits[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SubscriptionAudits[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testSubscriptionToManyAddOpSubscriptionPrices(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Subscription
+	var b, c, d, e SubscriptionPrice
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, subscriptionDBTypes, false, strmangle.SetComplement(subscriptionPrimaryKeyColumns, subscriptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*SubscriptionPrice{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, subscriptionPriceDBTypes, false, strmangle.SetComplement(subscriptionPricePrimaryKeyColumns, subscriptionPriceColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*SubscriptionPrice{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSubscriptionPrices(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SubscriptionID {
+			t.Error("foreign key was wrong value", a.ID, first.SubscriptionID)
+		}
+		if a.ID != second.SubscriptionID {
+			t.Error("foreign key was wrong value", a.ID, second.SubscriptionID)
+		}
+
+		if first.R.Subscription != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Subscription != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SubscriptionPrices[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SubscriptionPrices[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SubscriptionPrices().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testSubscriptionToOneUserUsingUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -514,7 +1096,7 @@ func testSubscriptionToOneUserUsingUser(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	local.UserID = foreign.ID
+	local.UserID = foreign.UUID
 	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -524,8 +1106,8 @@ func testSubscriptionToOneUserUsingUser(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if check.ID != foreign.ID {
-		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	if check.UUID != foreign.UUID {
+		t.Errorf("want: %v, got %v", foreign.UUID, check.UUID)
 	}
 
 	ranAfterSelectHook := false
@@ -555,6 +1137,67 @@ func testSubscriptionToOneUserUsingUser(t *testing.T) {
 	}
 }
 
+func testSubscriptionToOneServiceUsingService(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Subscription
+	var foreign Service
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, subscriptionDBTypes, false, subscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Subscription struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, serviceDBTypes, false, serviceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Service struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ServiceID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Service().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddServiceHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Service) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := SubscriptionSlice{&local}
+	if err = local.L.LoadService(ctx, tx, false, (*[]*Subscription)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Service == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Service = nil
+	if err = local.L.LoadService(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Service == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
 func testSubscriptionToOneSetOpUserUsingUser(t *testing.T) {
 	var err error
 
@@ -596,7 +1239,7 @@ func testSubscriptionToOneSetOpUserUsingUser(t *testing.T) {
 		if x.R.Subscriptions[0] != &a {
 			t.Error("failed to append to foreign relationship struct")
 		}
-		if a.UserID != x.ID {
+		if a.UserID != x.UUID {
 			t.Error("foreign key was wrong value", a.UserID)
 		}
 
@@ -607,8 +1250,65 @@ func testSubscriptionToOneSetOpUserUsingUser(t *testing.T) {
 			t.Fatal("failed to reload", err)
 		}
 
-		if a.UserID != x.ID {
-			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		if a.UserID != x.UUID {
+			t.Error("foreign key was wrong value", a.UserID, x.UUID)
+		}
+	}
+}
+func testSubscriptionToOneSetOpServiceUsingService(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Subscription
+	var b, c Service
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, subscriptionDBTypes, false, strmangle.SetComplement(subscriptionPrimaryKeyColumns, subscriptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serviceDBTypes, false, strmangle.SetComplement(servicePrimaryKeyColumns, serviceColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serviceDBTypes, false, strmangle.SetComplement(servicePrimaryKeyColumns, serviceColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Service{&b, &c} {
+		err = a.SetService(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Service != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Subscriptions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ServiceID != x.ID {
+			t.Error("foreign key was wrong value", a.ServiceID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ServiceID))
+		reflect.Indirect(reflect.ValueOf(&a.ServiceID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ServiceID != x.ID {
+			t.Error("foreign key was wrong value", a.ServiceID, x.ID)
 		}
 	}
 }
@@ -687,7 +1387,7 @@ func testSubscriptionsSelect(t *testing.T) {
 }
 
 var (
-	subscriptionDBTypes = map[string]string{`ID`: `integer`, `UserID`: `integer`, `ServiceName`: `character varying`, `Price`: `integer`, `StartDate`: `timestamp with time zone`, `EndDate`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`}
+	subscriptionDBTypes = map[string]string{`ID`: `integer`, `UserID`: `uuid`, `StartDate`: `timestamp with time zone`, `EndDate`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `ServiceID`: `integer`, `DeletedAt`: `timestamp with time zone`, `Version`: `integer`, `UUID`: `uuid`}
 	_                   = bytes.MinRead
 )
 
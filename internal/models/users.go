@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -26,12 +27,14 @@ type User struct {
 	// Primary key
 	ID int `boil:"id" json:"id" toml:"id" yaml:"id"`
 	// User login
-	Login string `boil:"login" json:"login" toml:"login" yaml:"login"`
+	Login null.String `boil:"login" json:"login,omitempty" toml:"login" yaml:"login,omitempty"`
 	// Password bcrypt hash
-	PasswordHash string `boil:"password_hash" json:"password_hash" toml:"password_hash" yaml:"password_hash"`
+	PasswordHash null.String `boil:"password_hash" json:"password_hash,omitempty" toml:"password_hash" yaml:"password_hash,omitempty"`
 	// Date created
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UUID      string    `boil:"uuid" json:"uuid" toml:"uuid" yaml:"uuid"`
+	// Admins see soft deleted subscriptions
+	IsAdmin bool `boil:"is_admin" json:"is_admin" toml:"is_admin" yaml:"is_admin"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -43,12 +46,14 @@ var UserColumns = struct {
 	PasswordHash string
 	CreatedAt    string
 	UUID         string
+	IsAdmin      string
 }{
 	ID:           "id",
 	Login:        "login",
 	PasswordHash: "password_hash",
 	CreatedAt:    "created_at",
 	UUID:         "uuid",
+	IsAdmin:      "is_admin",
 }
 
 var UserTableColumns = struct {
@@ -57,39 +62,55 @@ var UserTableColumns = struct {
 	PasswordHash string
 	CreatedAt    string
 	UUID         string
+	IsAdmin      string
 }{
 	ID:           "users.id",
 	Login:        "users.login",
 	PasswordHash: "users.password_hash",
 	CreatedAt:    "users.created_at",
 	UUID:         "users.uuid",
+	IsAdmin:      "users.is_admin",
 }
 
 // Generated where
 
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
 var UserWhere = struct {
 	ID           whereHelperint
-	Login        whereHelperstring
-	PasswordHash whereHelperstring
+	Login        whereHelpernull_String
+	PasswordHash whereHelpernull_String
 	CreatedAt    whereHelpertime_Time
 	UUID         whereHelperstring
+	IsAdmin      whereHelperbool
 }{
 	ID:           whereHelperint{field: "\"users\".\"id\""},
-	Login:        whereHelperstring{field: "\"users\".\"login\""},
-	PasswordHash: whereHelperstring{field: "\"users\".\"password_hash\""},
+	Login:        whereHelpernull_String{field: "\"users\".\"login\""},
+	PasswordHash: whereHelpernull_String{field: "\"users\".\"password_hash\""},
 	CreatedAt:    whereHelpertime_Time{field: "\"users\".\"created_at\""},
 	UUID:         whereHelperstring{field: "\"users\".\"uuid\""},
+	IsAdmin:      whereHelperbool{field: "\"users\".\"is_admin\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
+	AuthSessions  string
 	Subscriptions string
 }{
+	AuthSessions:  "AuthSessions",
 	Subscriptions: "Subscriptions",
 }
 
 // userR is where relationships are stored.
 type userR struct {
+	AuthSessions  AuthSessionSlice  `boil:"AuthSessions" json:"AuthSessions" toml:"AuthSessions" yaml:"AuthSessions"`
 	Subscriptions SubscriptionSlice `boil:"Subscriptions" json:"Subscriptions" toml:"Subscriptions" yaml:"Subscriptions"`
 }
 
@@ -98,6 +119,22 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (o *User) GetAuthSessions() AuthSessionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAuthSessions()
+}
+
+func (r *userR) GetAuthSessions() AuthSessionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AuthSessions
+}
+
 func (o *User) GetSubscriptions() SubscriptionSlice {
 	if o == nil {
 		return nil
@@ -118,9 +155,9 @@ func (r *userR) GetSubscriptions() SubscriptionSlice {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "login", "password_hash", "created_at", "uuid"}
-	userColumnsWithoutDefault = []string{"login", "password_hash"}
-	userColumnsWithDefault    = []string{"id", "created_at", "uuid"}
+	userAllColumns            = []string{"id", "login", "password_hash", "created_at", "uuid", "is_admin"}
+	userColumnsWithoutDefault = []string{}
+	userColumnsWithDefault    = []string{"id", "login", "password_hash", "created_at", "uuid", "is_admin"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -430,6 +467,20 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// AuthSessions retrieves all the auth_session's AuthSessions with an executor.
+func (o *User) AuthSessions(mods ...qm.QueryMod) authSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"auth_sessions\".\"user_id\"=?", o.UUID),
+	)
+
+	return AuthSessions(queryMods...)
+}
+
 // Subscriptions retrieves all the subscription's Subscriptions with an executor.
 func (o *User) Subscriptions(mods ...qm.QueryMod) subscriptionQuery {
 	var queryMods []qm.QueryMod
@@ -438,12 +489,125 @@ func (o *User) Subscriptions(mods ...qm.QueryMod) subscriptionQuery {
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"subscriptions\".\"user_id\"=?", o.ID),
+		qm.Where("\"subscriptions\".\"user_id\"=?", o.UUID),
 	)
 
 	return Subscriptions(queryMods...)
 }
 
+// LoadAuthSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAuthSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.UUID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.UUID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`auth_sessions`),
+		qm.WhereIn(`auth_sessions.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load auth_sessions")
+	}
+
+	var resultSlice []*AuthSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice auth_sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on auth_sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for auth_sessions")
+	}
+
+	if len(authSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AuthSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &authSessionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.UUID == foreign.UserID {
+				local.R.AuthSessions = append(local.R.AuthSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &authSessionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSubscriptions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (userL) LoadSubscriptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -477,13 +641,13 @@ func (userL) LoadSubscriptions(ctx context.Context, e boil.ContextExecutor, sing
 		if object.R == nil {
 			object.R = &userR{}
 		}
-		args[object.ID] = struct{}{}
+		args[object.UUID] = struct{}{}
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &userR{}
 			}
-			args[obj.ID] = struct{}{}
+			args[obj.UUID] = struct{}{}
 		}
 	}
 
@@ -501,6 +665,7 @@ func (userL) LoadSubscriptions(ctx context.Context, e boil.ContextExecutor, sing
 	query := NewQuery(
 		qm.From(`subscriptions`),
 		qm.WhereIn(`subscriptions.user_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`subscriptions.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -543,7 +708,7 @@ func (userL) LoadSubscriptions(ctx context.Context, e boil.ContextExecutor, sing
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
+			if local.UUID == foreign.UserID {
 				local.R.Subscriptions = append(local.R.Subscriptions, foreign)
 				if foreign.R == nil {
 					foreign.R = &subscriptionR{}
@@ -557,6 +722,59 @@ func (userL) LoadSubscriptions(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
+// AddAuthSessions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthSessions.
+// Sets related.R.User appropriately.
+func (o *User) AddAuthSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuthSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.UUID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"auth_sessions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, authSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.UUID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.UUID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AuthSessions: related,
+		}
+	} else {
+		o.R.AuthSessions = append(o.R.AuthSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &authSessionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddSubscriptions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.Subscriptions.
@@ -565,7 +783,7 @@ func (o *User) AddSubscriptions(ctx context.Context, exec boil.ContextExecutor,
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			rel.UserID = o.UUID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
@@ -575,7 +793,7 @@ func (o *User) AddSubscriptions(ctx context.Context, exec boil.ContextExecutor,
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
 				strmangle.WhereClause("\"", "\"", 2, subscriptionPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.UUID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -586,7 +804,7 @@ func (o *User) AddSubscriptions(ctx context.Context, exec boil.ContextExecutor,
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			rel.UserID = o.UUID
 		}
 	}
 
@@ -0,0 +1,40 @@
+// Effective-dated subscription prices
+package pricing
+
+import (
+	"time"
+
+	"github.com/zeleniy/test28/internal/models"
+)
+
+// Price change in effect in the month: the latest one effective not later than the month.
+// Months before the first change are charged with the earliest known price
+func EntryAt(prices models.SubscriptionPriceSlice, month time.Time) *models.SubscriptionPrice {
+
+	var effective, earliest *models.SubscriptionPrice
+
+	for _, price := range prices {
+		if earliest == nil || price.EffectiveFrom.Before(earliest.EffectiveFrom) {
+			earliest = price
+		}
+		if !price.EffectiveFrom.After(month) && (effective == nil || price.EffectiveFrom.After(effective.EffectiveFrom)) {
+			effective = price
+		}
+	}
+
+	if effective != nil {
+		return effective
+	}
+
+	return earliest
+}
+
+// Price in effect in the current month, empty price when there is no price at all
+func Current(prices models.SubscriptionPriceSlice) models.SubscriptionPrice {
+
+	if entry := EntryAt(prices, time.Now()); entry != nil {
+		return *entry
+	}
+
+	return models.SubscriptionPrice{}
+}
@@ -0,0 +1,25 @@
+// Lightweight records of the users managed outside of the service
+package provision
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
+)
+
+// Create records of the users seen for the first time. They are known by UUID only, without credentials
+func Users(ctx context.Context, exec boil.ContextExecutor, uuids ...string) error {
+
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	_, err := exec.ExecContext(ctx, `INSERT INTO users (uuid)
+		SELECT DISTINCT * FROM UNNEST($1::uuid[])
+		ON CONFLICT (uuid) DO NOTHING`,
+		pq.Array(uuids),
+	)
+
+	return err
+}
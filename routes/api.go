@@ -4,25 +4,65 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/zeleniy/test28/internal/auth"
+	"github.com/zeleniy/test28/internal/config"
 	"github.com/zeleniy/test28/internal/http/controllers"
+	"github.com/zeleniy/test28/internal/http/middleware"
 )
 
-func SetupRoutes(ginEngine *gin.Engine) {
+func SetupRoutes(ginEngine *gin.Engine, subscriptionsCfg config.SubscriptionsConfig, issuer *auth.Issuer) {
 
-	subscriptionCtrl := &controllers.SubscriptionController{}
+	subscriptionCtrl := &controllers.SubscriptionController{
+		IfMatchRequired: subscriptionsCfg.IfMatchRequired,
+		IntegerIDs:      subscriptionsCfg.IntegerIDs,
+	}
+	serviceCtrl := &controllers.ServiceController{}
+	userCtrl := &controllers.UserController{}
+	docsCtrl := &controllers.DocsController{}
+	authCtrl := &controllers.AuthController{Issuer: issuer}
 
 	ginEngine.GET("/ping", func(ginContext *gin.Context) {
 		ginContext.Header("Content-Type", "text/plain")
 		ginContext.String(http.StatusOK, "pong")
 	})
 
-	subscriptions := ginEngine.Group("/subscriptions")
+	ginEngine.GET("/openapi.json", docsCtrl.GetOpenAPI)
+	ginEngine.GET("/docs", docsCtrl.GetSwaggerUI)
+
+	authGroup := ginEngine.Group("/auth")
+
+	authGroup.POST("/login", authCtrl.Login)
+	authGroup.POST("/refresh", authCtrl.Refresh)
+	authGroup.POST("/logout", middleware.RequireUserMiddleware(), authCtrl.Logout)
+
+	subscriptions := ginEngine.Group("/subscriptions", middleware.RequireUserMiddleware())
 
 	subscriptions.GET("", subscriptionCtrl.GetSubscriptions)
+	subscriptions.GET("/export", subscriptionCtrl.ExportSubscriptions)
 	subscriptions.POST("", subscriptionCtrl.CreateSubscription)
+	subscriptions.POST("/import", subscriptionCtrl.ImportSubscriptions)
 	subscriptions.GET("/:id", subscriptionCtrl.ReadSubscription)
 	subscriptions.PATCH("/:id", subscriptionCtrl.UpdateSubscription)
 	subscriptions.PUT("/:id", subscriptionCtrl.UpdateSubscription)
 	subscriptions.DELETE("/:id", subscriptionCtrl.DeleteSubscription)
+	subscriptions.POST("/:id/restore", subscriptionCtrl.RestoreSubscription)
+	subscriptions.GET("/:id/prices", subscriptionCtrl.GetSubscriptionPrices)
+	subscriptions.GET("/:id/history", subscriptionCtrl.GetSubscriptionHistory)
 	subscriptions.POST("/report", subscriptionCtrl.GetAccountingReport)
+
+	// Catalog is public to read, changed by the users only
+	services := ginEngine.Group("/services")
+
+	services.GET("", serviceCtrl.GetServices)
+	services.POST("", middleware.RequireUserMiddleware(), serviceCtrl.CreateService)
+	services.GET("/:id", serviceCtrl.ReadService)
+	services.PUT("/:id", middleware.RequireUserMiddleware(), serviceCtrl.UpdateService)
+	services.DELETE("/:id", middleware.RequireUserMiddleware(), serviceCtrl.DeleteService)
+	services.GET("/:id/subscribers", middleware.RequireUserMiddleware(), serviceCtrl.GetSubscribers)
+
+	users := ginEngine.Group("/users", middleware.RequireUserMiddleware())
+
+	users.GET("", userCtrl.GetUsers)
+	users.GET("/:uuid", userCtrl.ReadUser)
+	users.GET("/:uuid/subscriptions", userCtrl.GetUserSubscriptions)
 }
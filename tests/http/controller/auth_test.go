@@ -0,0 +1,259 @@
+package controller
+
+import (
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/gin-gonic/gin"
+	"github.com/go-faker/faker/v4"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"github.com/zeleniy/test28/bootstrap"
+	"github.com/zeleniy/test28/internal/auth"
+	"github.com/zeleniy/test28/internal/config"
+	"github.com/zeleniy/test28/internal/models"
+)
+
+func TestLogin(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, _ := createAuthenticatedUser(t, tx, "correct horse")
+
+		w := sendRequest(t, http.MethodPost, "/auth/login", map[string]interface{}{
+			"login":    user.Login.String,
+			"password": "correct horse",
+		}, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+		gjsonBody := gjson.Parse(w.Body.String())
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, user.UUID, gjsonBody.Get("data.user.id").String())
+		assert.Equal(t, "Bearer", gjsonBody.Get("data.tokens.token_type").String())
+		assert.Greater(t, gjsonBody.Get("data.tokens.expires_in").Int(), int64(0))
+		assert.NotEmpty(t, gjsonBody.Get("data.tokens.refresh_token").String())
+
+		token := gjsonBody.Get("data.tokens.access_token").String()
+		w = sendRequest(t, http.MethodGet, "/subscriptions", nil, map[string]string{"Authorization": "Bearer " + token})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		for _, credentials := range []map[string]interface{}{
+			{"login": user.Login.String, "password": "wrong horse"},
+			{"login": "nobody", "password": "correct horse"},
+		} {
+			gjsonBody = sendAndTestRequest(t, http.MethodPost, "/auth/login", http.StatusUnauthorized, credentials)
+			assertErrorResponseStructure(t, gjsonBody, "unauthorized")
+		}
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/auth/login", http.StatusBadRequest, map[string]interface{}{
+			"login": user.Login.String,
+		})
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+
+		// Issued tokens are never stored for the replay
+		headers := map[string]string{"Idempotency-Key": faker.UUIDHyphenated()}
+		credentials := map[string]interface{}{"login": user.Login.String, "password": "correct horse"}
+
+		for i := 0; i < 2; i++ {
+			w = sendRequest(t, http.MethodPost, "/auth/login", credentials, headers)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+		}
+
+		stored, err := models.IdempotencyKeys(models.IdempotencyKeyWhere.Key.EQ(headers["Idempotency-Key"])).Exists(ctx, tx)
+		assert.NoError(t, err)
+		assert.False(t, stored, "Response with tokens is stored")
+	})
+}
+
+func TestRefreshToken(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, _ := createAuthenticatedUser(t, tx, "correct horse")
+
+		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/auth/login", http.StatusOK, map[string]interface{}{
+			"login":    user.Login.String,
+			"password": "correct horse",
+		})
+		refreshToken := gjsonBody.Get("data.tokens.refresh_token").String()
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/auth/refresh", http.StatusOK, map[string]interface{}{
+			"refresh_token": refreshToken,
+		})
+		assertResponseStructure(t, gjsonBody)
+		assert.NotEmpty(t, gjsonBody.Get("data.tokens.access_token").String())
+		assert.NotEqual(t, refreshToken, gjsonBody.Get("data.tokens.refresh_token").String())
+
+		// Refresh token is single use
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/auth/refresh", http.StatusUnauthorized, map[string]interface{}{
+			"refresh_token": refreshToken,
+		})
+		assertErrorResponseStructure(t, gjsonBody, "unauthorized")
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/auth/refresh", http.StatusBadRequest, nil)
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+	})
+}
+
+func TestLogout(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, _ := createAuthenticatedUser(t, tx, "correct horse")
+
+		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/auth/login", http.StatusOK, map[string]interface{}{
+			"login":    user.Login.String,
+			"password": "correct horse",
+		})
+		headers := map[string]string{"Authorization": "Bearer " + gjsonBody.Get("data.tokens.access_token").String()}
+		refreshToken := gjsonBody.Get("data.tokens.refresh_token").String()
+
+		w := sendRequest(t, http.MethodPost, "/auth/logout", nil, headers)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		// Both tokens of the session are revoked
+		w = sendRequest(t, http.MethodGet, "/subscriptions", nil, headers)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, `Bearer error="invalid_token"`, w.Header().Get("WWW-Authenticate"))
+
+		sendAndTestRequest(t, http.MethodPost, "/auth/refresh", http.StatusUnauthorized, map[string]interface{}{
+			"refresh_token": refreshToken,
+		})
+
+		// Sessions of the other users are intact
+		sendAndTestRequest(t, http.MethodGet, "/subscriptions", http.StatusOK, nil)
+
+		w = sendRequest(t, http.MethodPost, "/auth/logout", nil, map[string]string{"Authorization": ""})
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestAuthentication(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		// Subscriptions, users and changes of the catalog require the user, reading the catalog is public
+		for _, route := range [][2]string{
+			{http.MethodGet, "/subscriptions"},
+			{http.MethodGet, "/users?login=admin"},
+			{http.MethodPost, "/services"},
+			{http.MethodPut, "/services/1"},
+			{http.MethodDelete, "/services/1"},
+			{http.MethodGet, "/services/1/subscribers"},
+		} {
+			w := sendRequest(t, route[0], route[1], map[string]interface{}{"name": "Premier"}, map[string]string{"Authorization": ""})
+			assert.Equal(t, http.StatusUnauthorized, w.Code, route[1])
+			assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+			assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "unauthorized")
+		}
+
+		w := sendRequest(t, http.MethodGet, "/services", nil, map[string]string{"Authorization": ""})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		for authorization, challenge := range map[string]string{
+			"Basic dXNlcjpwYXNz": `Bearer error="invalid_request"`,
+			"Bearer":             `Bearer error="invalid_request"`,
+			"Bearer not.a.token": `Bearer error="invalid_token"`,
+		} {
+			w = sendRequest(t, http.MethodGet, "/subscriptions", nil, map[string]string{"Authorization": authorization})
+			assert.Equal(t, http.StatusUnauthorized, w.Code, authorization)
+			assert.Equal(t, challenge, w.Header().Get("WWW-Authenticate"), authorization)
+			assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "unauthorized")
+
+			// Bad token is not an error where the user is not required
+			for _, url := range []string{"/ping", "/services", "/docs"} {
+				w = sendRequest(t, http.MethodGet, url, nil, map[string]string{"Authorization": authorization})
+				assert.Equal(t, http.StatusOK, w.Code, url)
+				assert.Empty(t, w.Header().Get("WWW-Authenticate"), url)
+			}
+		}
+
+		expiredToken, err := issuer.Sign(auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   authUser.UUID,
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			},
+		})
+		assert.NoError(t, err)
+
+		w = sendRequest(t, http.MethodGet, "/subscriptions", nil, map[string]string{"Authorization": "Bearer " + expiredToken})
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, "token is expired", gjson.Get(w.Body.String(), "error.message").String())
+
+		user, accessToken := createAuthenticatedUser(t, tx, "correct horse")
+		session, err := models.AuthSessions(models.AuthSessionWhere.UserID.EQ(user.UUID)).One(ctx, tx)
+		assert.NoError(t, err)
+
+		// Token is accepted for the user of its session only
+		foreignToken, err := issuer.Sign(auth.Claims{
+			SessionID: session.ID,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   authUser.UUID,
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+		})
+		assert.NoError(t, err)
+
+		w = sendRequest(t, http.MethodGet, "/subscriptions", nil, map[string]string{"Authorization": "Bearer " + foreignToken})
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		// Access token outliving its session is not accepted
+		w = sendRequest(t, http.MethodGet, "/subscriptions", nil, map[string]string{"Authorization": "Bearer " + accessToken})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		session.ExpiresAt = time.Now().Add(-time.Minute)
+		_, err = session.Update(ctx, tx, boil.Whitelist(models.AuthSessionColumns.ExpiresAt))
+		assert.NoError(t, err)
+
+		w = sendRequest(t, http.MethodGet, "/subscriptions", nil, map[string]string{"Authorization": "Bearer " + accessToken})
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, `Bearer error="invalid_token"`, w.Header().Get("WWW-Authenticate"))
+	})
+}
+
+func TestSigningKeyRotation(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		cfg, err := config.Load("../../..")
+		assert.NoError(t, err)
+
+		// New key signs the tokens, the retired one still verifies the tokens issued before the rotation
+		cfg.Auth.Keys = []string{"next:another secret", "test:secret"}
+		cfg.Auth.KeyID = "next"
+		rotated, err := auth.NewIssuer(cfg.Auth)
+		assert.NoError(t, err)
+
+		engine := bootstrap.SetUpGin(gin.TestMode, cfg.Idempotency, cfg.Subscriptions, rotated, slog.Default())
+
+		tokens, err := rotated.Start(ctx, tx, authUser.UUID)
+		assert.NoError(t, err)
+
+		for _, token := range []string{accessToken, tokens.AccessToken} {
+			req, err := http.NewRequest(http.MethodGet, "/subscriptions", nil)
+			assert.NoError(t, err, "Failed to create request")
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+		}
+
+		// Tokens of the new key are not accepted where the key is not configured
+		w := sendRequest(t, http.MethodGet, "/subscriptions", nil, map[string]string{"Authorization": "Bearer " + tokens.AccessToken})
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		cfg.Auth.KeyID = "missing"
+		_, err = auth.NewIssuer(cfg.Auth)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+)
+
+var ginPathParam = regexp.MustCompile(`:(\w+)`)
+
+func TestOpenAPI(t *testing.T) {
+
+	response := sendRequest(t, http.MethodGet, "/openapi.json", nil, nil)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.True(t, gjson.Valid(response.Body.String()), "Specification is not a valid JSON")
+
+	spec := gjson.Parse(response.Body.String())
+	assert.True(t, strings.HasPrefix(spec.Get("openapi").String(), "3."), "Specification is not OpenAPI 3")
+
+	routes := map[string]bool{}
+
+	for _, route := range ginEngine.Routes() {
+		path := ginPathParam.ReplaceAllString(route.Path, "{$1}")
+		method := strings.ToLower(route.Method)
+		routes[path+" "+method] = true
+
+		assert.True(t,
+			spec.Get("paths").Get(gjson.Escape(path)).Get(method).Exists(),
+			"Route %s %s has no OpenAPI specification", route.Method, route.Path,
+		)
+	}
+
+	spec.Get("paths").ForEach(func(path, operations gjson.Result) bool {
+		operations.ForEach(func(method, _ gjson.Result) bool {
+			if method.String() != "parameters" {
+				assert.True(t, routes[path.String()+" "+method.String()], "Specification of %s %s has no route", method, path)
+			}
+			return true
+		})
+		return true
+	})
+}
+
+func TestSwaggerUI(t *testing.T) {
+
+	response := sendRequest(t, http.MethodGet, "/docs", nil, nil)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Contains(t, response.Header().Get("Content-Type"), "text/html")
+	assert.Contains(t, response.Body.String(), "/openapi.json")
+}
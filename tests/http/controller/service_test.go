@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"database/sql"
+	"math"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+	factory "github.com/zeleniy/test28/database/factories"
+)
+
+func TestGetServices(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		getService(t, tx, "Yandex Plus")
+		getService(t, tx, "Okko")
+
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, "/services?name=yandex", http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+
+		gjsonServices := gjsonBody.Get("data.services")
+		assert.True(t, gjsonServices.IsArray())
+		assert.Len(t, gjsonServices.Array(), 1)
+		assert.Equal(t, "Yandex Plus", gjsonServices.Get("0.name").String())
+		assert.Len(t, gjsonServices.Get("0").Map(), 3)
+	})
+}
+
+func TestCreateService(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		w := sendRequest(t, http.MethodPost, "/services", map[string]interface{}{
+			"name": "Kinopoisk",
+		}, nil)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, w.Header().Get("Location"), http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, "Kinopoisk", gjsonBody.Get("data.service.name").String())
+
+		// Names are unique regardless of case
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/services", http.StatusConflict, map[string]interface{}{
+			"name": "kinopoisk",
+		})
+		assertErrorResponseStructure(t, gjsonBody, "conflict")
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/services", http.StatusBadRequest, map[string]interface{}{})
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+	})
+}
+
+func TestUpdateService(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		service := getService(t, tx, "Yandex")
+
+		gjsonBody := sendAndTestRequest(t, http.MethodPut, "/services/"+strconv.Itoa(service.ID), http.StatusOK, map[string]interface{}{
+			"name": "Yandex Plus",
+		})
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, "Yandex Plus", gjsonBody.Get("data.service.name").String())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPut, "/services/"+strconv.Itoa(math.MaxInt32), http.StatusNotFound, map[string]interface{}{
+			"name": "Yandex Plus",
+		})
+		assertErrorResponseStructure(t, gjsonBody, "not_found")
+	})
+}
+
+func TestDeleteService(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		service := getService(t, tx, "Wink")
+
+		_, err := factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithNewUser(nil,
+				factory.UserLogin(null.StringFrom(faker.Username())),
+				factory.UserPasswordHash(null.StringFrom(faker.Password())),
+			),
+			factory.SubscriptionWithService(service),
+			withPrice(100),
+		)
+		assert.NoError(t, err, "Failed to create subscription")
+
+		// Service with subscriptions is kept
+		gjsonBody := sendAndTestRequest(t, http.MethodDelete, "/services/"+strconv.Itoa(service.ID), http.StatusConflict, nil)
+		assertErrorResponseStructure(t, gjsonBody, "conflict")
+		assert.Equal(t, "service has subscriptions", gjsonBody.Get("error.message").String())
+
+		// Deleted subscriptions keep the service until they are purged
+		service = getService(t, tx, "Kion")
+
+		_, err = factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithNewUser(nil,
+				factory.UserLogin(null.StringFrom(faker.Username())),
+				factory.UserPasswordHash(null.StringFrom(faker.Password())),
+			),
+			factory.SubscriptionWithService(service),
+			factory.SubscriptionDeletedAt(null.TimeFrom(time.Now())),
+			withPrice(100),
+		)
+		assert.NoError(t, err, "Failed to create subscription")
+
+		gjsonBody = sendAndTestRequest(t, http.MethodDelete, "/services/"+strconv.Itoa(service.ID), http.StatusConflict, nil)
+		assertErrorResponseStructure(t, gjsonBody, "conflict")
+		assert.Equal(t, "service has deleted subscriptions, it can be removed once they are purged", gjsonBody.Get("error.message").String())
+
+		service = getService(t, tx, "Sber")
+		sendAndTestRequest(t, http.MethodDelete, "/services/"+strconv.Itoa(service.ID), http.StatusNoContent, nil)
+		sendAndTestRequest(t, http.MethodGet, "/services/"+strconv.Itoa(service.ID), http.StatusNotFound, nil)
+	})
+}
+
+func TestGetServiceSubscribers(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		service := getService(t, tx, "Ivi")
+
+		users, err := factory.CreateAndInsertUsers(ctx, tx, 3,
+			factory.UserLoginFunc(func() (null.String, error) { return null.StringFrom(faker.Username()), nil }),
+			factory.UserPasswordHashFunc(func() (null.String, error) { return null.StringFrom(faker.Password()), nil }),
+		)
+		assert.NoError(t, err, "Failed to create users")
+
+		for i, user := range users {
+			_, err = factory.CreateAndInsertSubscription(ctx, tx,
+				factory.SubscriptionWithUser(user),
+				factory.SubscriptionWithService(service),
+				withPrice(100),
+				factory.SubscriptionStartDate(time.Date(2025, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC)),
+			)
+			assert.NoError(t, err, "Failed to create subscription")
+		}
+
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, "/services/"+strconv.Itoa(service.ID)+"/subscribers?limit=2", http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, "Ivi", gjsonBody.Get("data.service.name").String())
+		assert.Len(t, gjsonBody.Get("data.subscribers").Array(), 2)
+		assert.Equal(t, users[0].UUID, gjsonBody.Get("data.subscribers.0.user_id").String())
+		assert.Equal(t, "01-2025", gjsonBody.Get("data.subscribers.0.start_date").String())
+		assert.Equal(t, int64(2), gjsonBody.Get("meta.pagination.next_offset").Int())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/services/"+strconv.Itoa(math.MaxInt32)+"/subscribers", http.StatusNotFound, nil)
+		assertErrorResponseStructure(t, gjsonBody, "not_found")
+	})
+}
@@ -4,39 +4,76 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/csv"
 	"encoding/json"
+	"log/slog"
+	"math"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/ericlagergren/decimal"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/go-faker/faker/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/tidwall/gjson"
 	"github.com/zeleniy/test28/bootstrap"
 	factory "github.com/zeleniy/test28/database/factories"
+	"github.com/zeleniy/test28/internal/audit"
+	"github.com/zeleniy/test28/internal/auth"
+	"github.com/zeleniy/test28/internal/config"
+	"github.com/zeleniy/test28/internal/http/request"
+	"github.com/zeleniy/test28/internal/models"
+	"golang.org/x/crypto/bcrypt"
 )
 
 var (
 	ginEngine *gin.Engine
-	db        boil.ContextExecutor
+	db        *sql.DB
 	ctx       context.Context
+	issuer    *auth.Issuer
+	// User created for every test and their access token, sent unless the request has Authorization header
+	authUser    *models.User
+	accessToken string
 )
 
 func init() {
 
-	ginEngine = bootstrap.SetUpApp(gin.TestMode, os.Getenv("DB_TEST_URL"))
-	db = boil.GetContextDB()
+	cfg, err := config.Load("../../..")
+	if err != nil {
+		panic(err)
+	}
+
+	cfg.Gin.Mode = gin.TestMode
+	cfg.Auth.Keys = []string{"test:secret"}
+	cfg.Auth.KeyID = "test"
+	if url, ok := os.LookupEnv("DB_TEST_URL"); ok {
+		cfg.DB.URL = url
+	} else if name, ok := os.LookupEnv("DB_TEST_NAME"); ok {
+		cfg.DB.Name = name
+	}
+
+	ginEngine, db = bootstrap.SetUpApp(cfg)
 	ctx = context.Background()
+
+	if issuer, err = auth.NewIssuer(cfg.Auth); err != nil {
+		panic(err)
+	}
 }
 
 func withTransaction(t *testing.T, testFunc func(tx *sql.Tx)) {
 
-	tx, err := db.(*sql.DB).Begin()
+	tx, err := db.Begin()
 	if err != nil {
 		t.Fatalf("Cannot begin transaction: %v", err)
 	}
@@ -46,28 +83,61 @@ func withTransaction(t *testing.T, testFunc func(tx *sql.Tx)) {
 	boil.SetDB(tx)
 	defer boil.SetDB(originalDB)
 
+	authUser, accessToken = createAuthenticatedUser(t, tx, faker.Password())
+	defer func() { authUser, accessToken = nil, "" }()
+
 	testFunc(tx)
 }
 
+// Create user with the password and start their session
+func createAuthenticatedUser(t *testing.T, tx *sql.Tx, password string) (*models.User, string) {
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("Cannot hash password: %v", err)
+	}
+
+	user, err := factory.CreateAndInsertUser(ctx, tx,
+		factory.UserLogin(null.StringFrom(faker.Username())),
+		factory.UserPasswordHash(null.StringFrom(string(hash))),
+	)
+	if err != nil {
+		t.Fatalf("Cannot create user: %v", err)
+	}
+
+	tokens, err := issuer.Start(ctx, tx, user.UUID)
+	if err != nil {
+		t.Fatalf("Cannot start session: %v", err)
+	}
+
+	return user, tokens.AccessToken
+}
+
 func TestGetSubscriptions(t *testing.T) {
 
 	withTransaction(t, func(tx *sql.Tx) {
 
 		users, err := factory.CreateAndInsertUsers(ctx, tx, 3,
-			factory.UserLoginFunc(func() (string, error) { return faker.Username(), nil }),
-			factory.UserPasswordHashFunc(func() (string, error) { return faker.Password(), nil }),
+			factory.UserLoginFunc(func() (null.String, error) { return null.StringFrom(faker.Username()), nil }),
+			factory.UserPasswordHashFunc(func() (null.String, error) { return null.StringFrom(faker.Password()), nil }),
 		)
 		assert.NoError(t, err, "Failed to create users")
 
 		subscriptionsCount := 0
 		for _, user := range users {
+			// Subscriptions of the same user to the same service can not overlap
+			serviceNames := []string{"Okko", "Yandex", "Wink", "Sber", "Ivi"}
+			rand.Shuffle(len(serviceNames), func(i, j int) { serviceNames[i], serviceNames[j] = serviceNames[j], serviceNames[i] })
+
 			subscriptionsPerUser := rand.Intn(3) + 1
-			_, err = factory.CreateAndInsertSubscriptions(ctx, tx, subscriptionsPerUser,
-				factory.SubscriptionWithUser(user),
-				factory.SubscriptionServiceName([]string{"Okko", "Yandex", "Wink", "Sber", "Ivi"}[rand.Intn(5)]),
-				factory.SubscriptionPrice([]int{10, 20, 30, 40, 50}[rand.Intn(5)]),
-			)
-			assert.NoError(t, err, "Failed to create subscriptions for user %d", user.ID)
+			for _, serviceName := range serviceNames[:subscriptionsPerUser] {
+				_, err = factory.CreateAndInsertSubscription(ctx, tx,
+					factory.SubscriptionWithUser(user),
+					factory.SubscriptionWithService(getService(t, tx, serviceName)),
+					withPrice([]int64{10, 20, 30, 40, 50}[rand.Intn(5)]),
+				)
+				assert.NoError(t, err, "Failed to create subscriptions for user %d", user.ID)
+			}
 			subscriptionsCount += subscriptionsPerUser
 		}
 
@@ -80,7 +150,7 @@ func TestGetSubscriptions(t *testing.T) {
 		assert.Len(t, gjsonSubscriptions.Array(), subscriptionsCount)
 
 		gjsonSubscriptions.ForEach(func(_, gjsonSubscription gjson.Result) bool {
-			assert.Len(t, gjsonSubscription.Map(), 4)
+			assert.Len(t, gjsonSubscription.Map(), 10)
 			assert.Len(t, gjsonSubscription.Get("user_id").String(), 36)
 			assert.NotEmpty(t, gjsonSubscription.Get("service_name").String())
 			assert.Greater(t, gjsonSubscription.Get("price").Int(), int64(0))
@@ -90,216 +160,1631 @@ func TestGetSubscriptions(t *testing.T) {
 	})
 }
 
+func TestGetSubscriptionsPagination(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
+
+		for i, price := range []int64{30, 10, 50, 20, 40} {
+			_, err = factory.CreateAndInsertSubscription(ctx, tx,
+				factory.SubscriptionWithUser(user),
+				factory.SubscriptionWithService(getService(t, tx, []string{"Okko", "Yandex", "Wink", "Sber", "Ivi"}[i])),
+				withPrice(price),
+				factory.SubscriptionCreatedAt(time.Date(2025, 1, i+1, 0, 0, 0, 0, time.UTC)),
+			)
+			assert.NoError(t, err, "Failed to create subscription")
+		}
+
+		// Keyset mode walks through all records
+		var prices []int64
+		url := "/subscriptions?limit=2&with_total=true"
+		for page := 0; page < 3; page++ {
+			gjsonBody := sendAndTestRequest(t, http.MethodGet, url, http.StatusOK, nil)
+			assertResponseStructure(t, gjsonBody)
+			assert.Equal(t, int64(5), gjsonBody.Get("meta.pagination.total").Int())
+			assert.Equal(t, int64(2), gjsonBody.Get("meta.pagination.limit").Int())
+
+			for _, gjsonPrice := range gjsonBody.Get("data.subscriptions.#.price").Array() {
+				prices = append(prices, gjsonPrice.Int())
+			}
+
+			nextCursor := gjsonBody.Get("meta.pagination.next_cursor")
+			if page < 2 {
+				assert.NotEmpty(t, nextCursor.String())
+			} else {
+				assert.Nil(t, nextCursor.Value())
+			}
+			url = "/subscriptions?limit=2&with_total=true&cursor=" + nextCursor.String()
+		}
+		assert.Equal(t, []int64{30, 10, 50, 20, 40}, prices)
+
+		// Offset mode with custom sort
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, "/subscriptions?limit=2&offset=1&sort=-price", http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, "[40,30]", gjsonBody.Get("data.subscriptions.#.price").Raw)
+		assert.Equal(t, int64(3), gjsonBody.Get("meta.pagination.next_offset").Int())
+		assert.False(t, gjsonBody.Get("meta.pagination.total").Exists())
+
+		sendAndTestRequest(t, http.MethodGet, "/subscriptions?sort=password", http.StatusBadRequest, nil)
+		sendAndTestRequest(t, http.MethodGet, "/subscriptions?limit=1000", http.StatusBadRequest, nil)
+		sendAndTestRequest(t, http.MethodGet, "/subscriptions?cursor=!!!", http.StatusBadRequest, nil)
+	})
+}
+
 func TestGetAccountingReport(t *testing.T) {
 
 	withTransaction(t, func(tx *sql.Tx) {
 
 		user, err := factory.CreateAndInsertUser(ctx, tx,
-			factory.UserLoginFunc(func() (string, error) { return faker.Username(), nil }),
-			factory.UserPasswordHashFunc(func() (string, error) { return faker.Password(), nil }),
+			factory.UserLoginFunc(func() (null.String, error) { return null.StringFrom(faker.Username()), nil }),
+			factory.UserPasswordHashFunc(func() (null.String, error) { return null.StringFrom(faker.Password()), nil }),
 		)
 		assert.NoError(t, err, "Failed to create users")
 
 		_, err = factory.CreateAndInsertSubscription(ctx, tx,
 			factory.SubscriptionWithUser(user),
-			factory.SubscriptionServiceName("Yandex"),
-			factory.SubscriptionPrice(10),
+			factory.SubscriptionWithService(getService(t, tx, "Yandex")),
+			withPrice(10),
+			factory.SubscriptionStartDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			factory.SubscriptionEndDate(null.TimeFrom(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))),
 		)
 		assert.NoError(t, err, "Failed to create subscriptions for user %d", user.ID)
 
 		_, err = factory.CreateAndInsertSubscription(ctx, tx,
 			factory.SubscriptionWithUser(user),
-			factory.SubscriptionServiceName("Okko"),
-			factory.SubscriptionPrice(20),
+			factory.SubscriptionWithService(getService(t, tx, "Okko")),
+			withPrice(20),
+			factory.SubscriptionStartDate(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)),
+			factory.SubscriptionEndDate(null.TimeFrom(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))),
 		)
 		assert.NoError(t, err, "Failed to create subscriptions for user %d", user.ID)
 
-		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{})
+		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
+			"to_date": "31-12-2025",
+		})
 		assertResponseStructure(t, gjsonBody)
 
-		assert.Equal(t, gjsonBody.Get("data.count").Int(), int64(2))
-		assert.Equal(t, gjsonBody.Get("data.sum").Int(), int64(30))
+		// 3 months of Yandex and 1 month of Okko
+		assert.Equal(t, int64(2), gjsonBody.Get("data.count").Int())
+		assert.Equal(t, int64(50), gjsonBody.Get("data.sum").Int())
+		assert.Len(t, gjsonBody.Get("data.subscriptions").Array(), 2)
+		assert.Equal(t, int64(3), gjsonBody.Get("data.subscriptions.#(service_name==\"Yandex\").months").Int())
+		assert.Equal(t, int64(30), gjsonBody.Get("data.subscriptions.#(service_name==\"Yandex\").sum").Int())
+
+		// Period overlaps only partially
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
+			"from_date": "15-02-2025",
+			"to_date":   "15-02-2025",
+		})
+
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, int64(2), gjsonBody.Get("data.count").Int())
+		assert.Equal(t, int64(30), gjsonBody.Get("data.sum").Int())
 
 		// Test with another one user
 
 		user, err = factory.CreateAndInsertUser(ctx, tx,
-			factory.UserLoginFunc(func() (string, error) { return faker.Username(), nil }),
-			factory.UserPasswordHashFunc(func() (string, error) { return faker.Password(), nil }),
+			factory.UserLoginFunc(func() (null.String, error) { return null.StringFrom(faker.Username()), nil }),
+			factory.UserPasswordHashFunc(func() (null.String, error) { return null.StringFrom(faker.Password()), nil }),
 		)
 		assert.NoError(t, err, "Failed to create users")
 
 		_, err = factory.CreateAndInsertSubscription(ctx, tx,
 			factory.SubscriptionWithUser(user),
-			factory.SubscriptionServiceName("Ivi"),
-			factory.SubscriptionPrice(10),
+			factory.SubscriptionWithService(getService(t, tx, "Ivi")),
+			withPrice(10),
+			factory.SubscriptionStartDate(time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)),
 		)
 		assert.NoError(t, err, "Failed to create subscriptions for user %d", user.ID)
 
+		// Subscription without end date is ongoing
 		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
-			"user_id": user.UUID,
+			"user_id":   user.UUID,
+			"from_date": "01-01-2025",
+			"to_date":   "31-03-2025",
 		})
 
 		assertResponseStructure(t, gjsonBody)
-		assert.Equal(t, gjsonBody.Get("data.count").Int(), int64(1))
-		assert.Equal(t, gjsonBody.Get("data.sum").Int(), int64(10))
+		assert.Equal(t, int64(1), gjsonBody.Get("data.count").Int())
+		assert.Equal(t, int64(30), gjsonBody.Get("data.sum").Int())
+		assert.Equal(t, int64(3), gjsonBody.Get("data.subscriptions.0.months").Int())
 
 		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
 			"user_id":      user.UUID,
 			"service_name": "Ivi",
-			"to":           "01-01-2025",
+			"to_date":      "01-12-2024",
 		})
 
 		assertResponseStructure(t, gjsonBody)
-		assert.Equal(t, gjsonBody.Get("data.count").Int(), int64(1))
-		assert.Equal(t, gjsonBody.Get("data.sum").Int(), int64(10))
+		assert.Equal(t, int64(1), gjsonBody.Get("data.count").Int())
+		assert.Equal(t, int64(20), gjsonBody.Get("data.sum").Int())
 
 		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
 			"user_id":      user.UUID,
 			"service_name": "Okko",
-			"from":         "01-01-1901",
+			"from_date":    "01-01-1901",
+		})
+
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, int64(0), gjsonBody.Get("data.count").Int())
+		assert.Equal(t, int64(0), gjsonBody.Get("data.sum").Int())
+
+		// Subscription started after the period is not billed
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
+			"user_id": user.UUID,
+			"to_date": "31-10-2024",
+		})
+
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, int64(0), gjsonBody.Get("data.count").Int())
+		assert.Equal(t, int64(0), gjsonBody.Get("data.sum").Int())
+
+		// Period without end date is closed by the current month
+		user, err = factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLoginFunc(func() (null.String, error) { return null.StringFrom(faker.Username()), nil }),
+			factory.UserPasswordHashFunc(func() (null.String, error) { return null.StringFrom(faker.Password()), nil }),
+		)
+		assert.NoError(t, err, "Failed to create users")
+
+		currentMonth := time.Date(time.Now().Year(), time.Now().Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		_, err = factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithUser(user),
+			factory.SubscriptionWithService(getService(t, tx, "Ivi")),
+			withPrice(10),
+			factory.SubscriptionStartDate(currentMonth.AddDate(0, -1, 0)),
+		)
+		assert.NoError(t, err, "Failed to create subscriptions for user %d", user.ID)
+
+		_, err = factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithUser(user),
+			factory.SubscriptionWithService(getService(t, tx, "Okko")),
+			withPrice(20),
+			factory.SubscriptionStartDate(currentMonth.AddDate(0, 1, 0)),
+		)
+		assert.NoError(t, err, "Failed to create subscriptions for user %d", user.ID)
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
+			"user_id": user.UUID,
 		})
 
 		assertResponseStructure(t, gjsonBody)
-		assert.Equal(t, gjsonBody.Get("data.count").Int(), int64(0))
-		assert.Equal(t, gjsonBody.Get("data.sum").Int(), int64(0))
+		assert.Equal(t, int64(1), gjsonBody.Get("data.count").Int())
+		assert.Equal(t, int64(20), gjsonBody.Get("data.sum").Int())
+		assert.Equal(t, int64(2), gjsonBody.Get("data.subscriptions.0.months").Int())
 	})
 }
 
-func TestCreateSubscription(t *testing.T) {
+func TestGetAccountingReportGroupBy(t *testing.T) {
 
 	withTransaction(t, func(tx *sql.Tx) {
 
-		user, err := factory.CreateAndInsertUser(ctx, tx,
-			factory.UserLogin(faker.Username()),
-			factory.UserPasswordHash(faker.Password()),
+		users, err := factory.CreateAndInsertUsers(ctx, tx, 2,
+			factory.UserLoginFunc(func() (null.String, error) { return null.StringFrom(faker.Username()), nil }),
+			factory.UserPasswordHashFunc(func() (null.String, error) { return null.StringFrom(faker.Password()), nil }),
 		)
+		assert.NoError(t, err, "Failed to create users")
 
-		assert.NoError(t, err, "Failed to create user")
+		yandex := getService(t, tx, "Yandex")
+		okko := getService(t, tx, "Okko")
+
+		for _, mods := range [][]factory.SubscriptionMod{
+			{
+				factory.SubscriptionWithUser(users[0]),
+				factory.SubscriptionWithService(yandex),
+				withPrice(10),
+				factory.SubscriptionStartDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+				factory.SubscriptionEndDate(null.TimeFrom(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))),
+			},
+			{
+				factory.SubscriptionWithUser(users[0]),
+				factory.SubscriptionWithService(okko),
+				withPrice(20),
+				factory.SubscriptionStartDate(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)),
+				factory.SubscriptionEndDate(null.TimeFrom(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))),
+			},
+			{
+				factory.SubscriptionWithUser(users[1]),
+				factory.SubscriptionWithService(yandex),
+				withPrice(5),
+				factory.SubscriptionStartDate(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		} {
+			_, err = factory.CreateAndInsertSubscription(ctx, tx, mods...)
+			assert.NoError(t, err, "Failed to create subscription")
+		}
 
-		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusOK, map[string]interface{}{
-			"user_id":      user.UUID,
-			"service_name": "Okko",
-			"price":        100,
-		})
+		period := map[string]interface{}{
+			"from_date": "01-12-2024",
+			"to_date":   "30-04-2025",
+		}
 
+		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, period)
 		assertResponseStructure(t, gjsonBody)
-		gjsonSubscription := gjsonBody.Get("data.subscription")
-		assert.True(t, gjsonSubscription.Exists(), "Response does not contain 'data.subscription' key")
-		assert.Len(t, gjsonSubscription.Map(), 4)
-		assert.Equal(t, user.UUID, gjsonSubscription.Get("user_id").String())
-		assert.Equal(t, "Okko", gjsonSubscription.Get("service_name").String())
-		assert.Equal(t, int64(100), gjsonSubscription.Get("price").Int())
-		assert.IsType(t, "", gjsonSubscription.Get("start_date").Value())
+		assert.Equal(t, int64(65), gjsonBody.Get("data.sum").Int())
+		assert.False(t, gjsonBody.Get("data.rows").Exists())
+
+		// Months without subscriptions are zero-filled
+		period["group_by"] = []string{"month"}
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, period)
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, int64(65), gjsonBody.Get("data.sum").Int())
+		assert.Equal(t, `["12-2024","01-2025","02-2025","03-2025","04-2025"]`, gjsonBody.Get("data.rows.#.month").Raw)
+		assert.Equal(t, "[0,10,35,15,5]", gjsonBody.Get("data.rows.#.sum").Raw)
+		assert.Equal(t, "[0,1,3,2,1]", gjsonBody.Get("data.rows.#.count").Raw)
+		assert.False(t, gjsonBody.Get("data.rows.0.service_id").Exists())
+
+		// Every service gets every month
+		period["group_by"] = []string{"service", "month"}
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, period)
+		assertResponseStructure(t, gjsonBody)
+
+		gjsonRows := gjsonBody.Get("data.rows")
+		assert.Len(t, gjsonRows.Array(), 10)
+		assert.Equal(t, `["Okko","Yandex"]`, gjsonBody.Get(`data.rows.#(month=="02-2025")#.service_name`).Raw)
+		assert.Equal(t, "[20,15]", gjsonBody.Get(`data.rows.#(month=="02-2025")#.sum`).Raw)
+		assert.Equal(t, "[0,0]", gjsonBody.Get(`data.rows.#(month=="12-2024")#.count`).Raw)
+		assert.Equal(t, int64(okko.ID), gjsonRows.Get("0.service_id").Int())
+
+		var total int64
+		for _, gjsonSum := range gjsonRows.Get("#.sum").Array() {
+			total += gjsonSum.Int()
+		}
+		assert.Equal(t, gjsonBody.Get("data.sum").Int(), total)
+
+		// Rows of keys without month are not filled
+		period["group_by"] = []string{"user"}
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, period)
+		assertResponseStructure(t, gjsonBody)
+		assert.Len(t, gjsonBody.Get("data.rows").Array(), 2)
+		assert.Equal(t, int64(50), gjsonBody.Get(`data.rows.#(user_id=="`+users[0].UUID+`").sum`).Int())
+		assert.Equal(t, int64(2), gjsonBody.Get(`data.rows.#(user_id=="`+users[0].UUID+`").count`).Int())
+		assert.Equal(t, int64(15), gjsonBody.Get(`data.rows.#(user_id=="`+users[1].UUID+`").sum`).Int())
+
+		period["group_by"] = []string{"week"}
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusBadRequest, period)
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+		assert.Equal(t, "oneof", gjsonBody.Get("error.details.0.rule").String())
 	})
 }
 
-func TestReadSubscription(t *testing.T) {
+func TestGetAccountingReportCurrencies(t *testing.T) {
 
 	withTransaction(t, func(tx *sql.Tx) {
 
 		user, err := factory.CreateAndInsertUser(ctx, tx,
-			factory.UserLogin(faker.Username()),
-			factory.UserPasswordHash(faker.Password()),
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
 		)
-
 		assert.NoError(t, err, "Failed to create user")
 
-		subscription, err := factory.CreateAndInsertSubscription(ctx, tx,
-			factory.SubscriptionUserID(user.ID),
-			factory.SubscriptionServiceName("Ivi"),
-			factory.SubscriptionPrice(100),
+		_, err = factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithUser(user),
+			factory.SubscriptionWithService(getService(t, tx, "Yandex")),
+			withPrice(10000),
+			factory.SubscriptionStartDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			factory.SubscriptionEndDate(null.TimeFrom(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))),
 		)
+		assert.NoError(t, err, "Failed to create subscription")
 
+		_, err = factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithUser(user),
+			factory.SubscriptionWithService(getService(t, tx, "Netflix")),
+			factory.SubscriptionWithNewSubscriptionPrices(nil, 1,
+				factory.SubscriptionPricePrice(1000),
+				factory.SubscriptionPriceCurrency("USD"),
+			),
+			factory.SubscriptionStartDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			factory.SubscriptionEndDate(null.TimeFrom(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))),
+		)
 		assert.NoError(t, err, "Failed to create subscription")
 
-		gjsonBody := sendAndTestRequest(t, http.MethodGet, "/subscriptions/"+strconv.Itoa(subscription.ID), http.StatusOK, nil)
+		// January rate is direct, February one is reversed, March one is missing
+		for _, rate := range []*models.ExchangeRate{
+			{Base: "USD", Quote: "RUB", Month: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Rate: types.NewDecimal(decimal.New(90, 0))},
+			{Base: "EUR", Quote: "RUB", Month: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Rate: types.NewDecimal(decimal.New(100, 0))},
+			{Base: "RUB", Quote: "USD", Month: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), Rate: types.NewDecimal(decimal.New(1, 2))},
+		} {
+			assert.NoError(t, factory.InsertExchangeRate(ctx, tx, rate), "Failed to create exchange rate")
+		}
+
+		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
+			"user_id":   user.UUID,
+			"from_date": "01-01-2025",
+			"to_date":   "31-03-2025",
+		})
 		assertResponseStructure(t, gjsonBody)
 
-		gjsonSubscription := gjsonBody.Get("data.subscription")
-		assert.True(t, gjsonSubscription.Exists())
-		assert.True(t, gjsonSubscription.IsObject())
-		assert.Len(t, gjsonSubscription.Map(), 4)
-		assert.Equal(t, user.UUID, gjsonSubscription.Get("user_id").String())
-		assert.Equal(t, "Ivi", gjsonSubscription.Get("service_name").Value())
-		assert.Equal(t, int64(100), gjsonSubscription.Get("price").Int())
-		assert.IsType(t, "", gjsonSubscription.Get("start_date").Value())
+		// 3 months of 100.00 RUB plus $10 at 90 and 100 rubles, March dollars can not be converted
+		assert.Equal(t, "RUB", gjsonBody.Get("data.currency").String())
+		assert.Equal(t, gjson.Null, gjsonBody.Get("data.sum").Type)
+		assert.Equal(t, int64(30000), gjsonBody.Get("data.subscriptions.#(service_name==\"Yandex\").sum").Int())
+		assert.Equal(t, gjson.Null, gjsonBody.Get("data.subscriptions.#(service_name==\"Netflix\").sum").Type)
+		assert.Equal(t, int64(3), gjsonBody.Get("data.subscriptions.#(service_name==\"Netflix\").months").Int())
+
+		assert.Len(t, gjsonBody.Get("data.subtotals").Array(), 2)
+		assert.Equal(t, int64(30000), gjsonBody.Get("data.subtotals.#(currency==\"RUB\").sum").Int())
+		assert.Equal(t, int64(3000), gjsonBody.Get("data.subtotals.#(currency==\"USD\").amount").Int())
+		assert.Equal(t, int64(3), gjsonBody.Get("data.subtotals.#(currency==\"USD\").months").Int())
+		assert.Equal(t, gjson.Null, gjsonBody.Get("data.subtotals.#(currency==\"USD\").sum").Type)
+
+		assert.Len(t, gjsonBody.Get("data.missing_rates").Array(), 1)
+		assert.Equal(t, "USD", gjsonBody.Get("data.missing_rates.0.currency").String())
+		assert.Equal(t, "03-2025", gjsonBody.Get("data.missing_rates.0.month").String())
+
+		// Rubles are converted to dollars at the same rates rounded to cents
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
+			"user_id":   user.UUID,
+			"from_date": "01-01-2025",
+			"to_date":   "28-02-2025",
+			"currency":  "USD",
+		})
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, "USD", gjsonBody.Get("data.currency").String())
+		assert.Equal(t, int64(2000+111+100), gjsonBody.Get("data.sum").Int())
+		assert.Empty(t, gjsonBody.Get("data.missing_rates").Array())
+
+		// Dollars and rubles are converted to euros through the cross rate of January
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
+			"user_id":   user.UUID,
+			"from_date": "01-01-2025",
+			"to_date":   "31-01-2025",
+			"currency":  "EUR",
+		})
+		assert.Equal(t, int64(900+100), gjsonBody.Get("data.sum").Int())
+		assert.Empty(t, gjsonBody.Get("data.missing_rates").Array())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusBadRequest, map[string]interface{}{
+			"currency": "XYZ",
+		})
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+		assert.Equal(t, "iso4217", gjsonBody.Get("error.details.0.rule").String())
 	})
 }
 
-func TestUpdateSubscription(t *testing.T) {
+func TestExportSubscriptions(t *testing.T) {
 
 	withTransaction(t, func(tx *sql.Tx) {
 
 		user, err := factory.CreateAndInsertUser(ctx, tx,
-			factory.UserLogin(faker.Username()),
-			factory.UserPasswordHash(faker.Password()),
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
 		)
-
 		assert.NoError(t, err, "Failed to create user")
 
-		subscription, err := factory.CreateAndInsertSubscription(ctx, tx,
-			factory.SubscriptionUserID(user.ID),
-			factory.SubscriptionServiceName("Ivi"),
-			factory.SubscriptionPrice(100),
+		yandex := getService(t, tx, "Yandex")
+		okko := getService(t, tx, "Okko")
+
+		first, err := factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithUser(user),
+			factory.SubscriptionWithService(yandex),
+			withPrice(19900),
+			factory.SubscriptionStartDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			factory.SubscriptionEndDate(null.TimeFrom(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))),
 		)
+		assert.NoError(t, err, "Failed to create subscription")
 
+		second, err := factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithUser(user),
+			factory.SubscriptionWithService(okko),
+			withPrice(39900),
+			factory.SubscriptionStartDate(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)),
+		)
 		assert.NoError(t, err, "Failed to create subscription")
 
-		httpMethod := []string{http.MethodPatch, http.MethodPut}[rand.Intn(2)]
-		sendAndTestRequest(t, httpMethod, "/subscriptions/"+strconv.Itoa(subscription.ID), http.StatusMethodNotAllowed, nil)
+		url := "/subscriptions/export?user_id=" + user.UUID
+
+		w := sendRequest(t, http.MethodGet, url, nil, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "subscriptions.csv")
+
+		records, err := csv.NewReader(w.Body).ReadAll()
+		assert.NoError(t, err, "Failed to parse CSV")
+		assert.Len(t, records, 3)
+		assert.Equal(t, []string{"id", "user_id", "service_id", "service_name", "price", "currency", "start_date", "end_date", "created_at", "deleted_at"}, records[0])
+		assert.Equal(t, []string{first.UUID, user.UUID, strconv.Itoa(yandex.ID), "Yandex", "19900", "RUB", "01-2025", "03-2025"}, records[1][:8])
+		assert.Equal(t, []string{second.UUID, "39900", ""}, []string{records[2][0], records[2][4], records[2][7]})
+
+		// Trailers tell the complete export from the truncated one
+		assert.Equal(t, "complete", w.Result().Trailer.Get("Export-Status"))
+		assert.Equal(t, "2", w.Result().Trailer.Get("Export-Rows"))
+
+		// Format is negotiated by the Accept header unless given explicitly
+		w = sendRequest(t, http.MethodGet, url+"&service_name=Okko", nil, map[string]string{"Accept": "application/x-ndjson"})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+		lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+		assert.Len(t, lines, 1)
+		assert.Equal(t, second.UUID, gjson.Get(lines[0], "id").String())
+		assert.Equal(t, "02-2025", gjson.Get(lines[0], "start_date").String())
+		assert.Nil(t, gjson.Get(lines[0], "end_date").Value())
+
+		w = sendRequest(t, http.MethodGet, url+"&format=ndjson&to_date=31-01-2025", nil, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, strings.Count(w.Body.String(), "\n"))
+
+		w = sendRequest(t, http.MethodGet, url+"&format=xml", nil, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "validation_failed")
 	})
 }
 
-func TestDeleteSubscription(t *testing.T) {
+func TestCreateSubscription(t *testing.T) {
 
 	withTransaction(t, func(tx *sql.Tx) {
 
 		user, err := factory.CreateAndInsertUser(ctx, tx,
-			factory.UserLogin(faker.Username()),
-			factory.UserPasswordHash(faker.Password()),
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
 		)
 
 		assert.NoError(t, err, "Failed to create user")
 
-		subscription, err := factory.CreateAndInsertSubscription(ctx, tx,
-			factory.SubscriptionUserID(user.ID),
-			factory.SubscriptionServiceName("Ivi"),
-			factory.SubscriptionPrice(100),
-		)
+		okko := getService(t, tx, "Okko")
+		getService(t, tx, "Wink")
+		getService(t, tx, "Ivi")
 
-		assert.NoError(t, err, "Failed to create subscription")
+		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusCreated, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Okko",
+			"price":        100,
+			"start_date":   "07-2025",
+			"end_date":     "12-2025",
+		})
+
+		assertResponseStructure(t, gjsonBody)
+		gjsonSubscription := gjsonBody.Get("data.subscription")
+		assert.True(t, gjsonSubscription.Exists(), "Response does not contain 'data.subscription' key")
+		assert.Len(t, gjsonSubscription.Map(), 10)
+		assert.Equal(t, user.UUID, gjsonSubscription.Get("user_id").String())
+		assert.Equal(t, "Okko", gjsonSubscription.Get("service_name").String())
+		assert.Equal(t, int64(okko.ID), gjsonSubscription.Get("service_id").Int())
+		assert.Equal(t, int64(100), gjsonSubscription.Get("price").Int())
+		assert.Equal(t, "RUB", gjsonSubscription.Get("currency").String())
+		assert.Equal(t, "07-2025", gjsonSubscription.Get("start_date").String())
+		assert.Equal(t, "12-2025", gjsonSubscription.Get("end_date").String())
+		assert.Len(t, gjsonSubscription.Get("id").String(), 36)
+		assert.NotEmpty(t, gjsonSubscription.Get("created_at").String())
+
+		// Created subscription is addressable by Location header
+		w := sendRequest(t, http.MethodPost, "/subscriptions", map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Wink",
+			"price":        100,
+			"start_date":   "07-2025",
+		}, nil)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		location := w.Header().Get("Location")
+		assert.Equal(t, "/subscriptions/"+gjson.Get(w.Body.String(), "data.subscription.id").String(), location)
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, location, http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, "Wink", gjsonBody.Get("data.subscription.service_name").String())
+
+		// End date is optional
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusCreated, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Ivi",
+			"price":        100,
+			"start_date":   "07-2025",
+		})
+
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, "07-2025", gjsonBody.Get("data.subscription.start_date").String())
+		assert.Nil(t, gjsonBody.Get("data.subscription.end_date").Value())
+
+		// Service can be referenced by ID
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusCreated, map[string]interface{}{
+			"user_id":    user.UUID,
+			"service_id": okko.ID,
+			"price":      100,
+			"start_date": "01-2026",
+			"end_date":   "06-2026",
+		})
+		assert.Equal(t, "Okko", gjsonBody.Get("data.subscription.service_name").String())
+
+		// Price can be set in any ISO 4217 currency
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusCreated, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Okko",
+			"price":        999,
+			"currency":     "USD",
+			"start_date":   "07-2026",
+		})
+		assert.Equal(t, int64(999), gjsonBody.Get("data.subscription.price").Int())
+		assert.Equal(t, "USD", gjsonBody.Get("data.subscription.currency").String())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusBadRequest, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Okko",
+			"price":        999,
+			"currency":     "usd",
+			"start_date":   "07-2025",
+		})
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+		assert.Equal(t, "iso4217", gjsonBody.Get("error.details.0.rule").String())
+
+		// Service name must match the catalog exactly
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusNotFound, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "okko",
+			"price":        100,
+			"start_date":   "07-2025",
+		})
+		assertErrorResponseStructure(t, gjsonBody, "not_found")
+
+		// Service is required
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusBadRequest, map[string]interface{}{
+			"user_id":    user.UUID,
+			"price":      100,
+			"start_date": "07-2025",
+		})
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+
+		// Start date is required
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusBadRequest, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Ivi",
+			"price":        100,
+		})
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
 
-		sendAndTestRequest(t, http.MethodDelete, "/subscriptions/"+strconv.Itoa(subscription.ID), http.StatusNoContent, nil)
+		// End date can not be earlier than start date
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusBadRequest, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Ivi",
+			"price":        100,
+			"start_date":   "07-2025",
+			"end_date":     "06-2025",
+		})
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+		assert.Equal(t, "monthgtefield", gjsonBody.Get("error.details.0.rule").String())
 	})
 }
 
-func sendAndTestRequest(t *testing.T, httpMethod, url string, code int, data map[string]interface{}) gjson.Result {
+func TestCreateSubscriptionProvisionsUser(t *testing.T) {
 
-	jsonData, err := json.Marshal(data)
+	withTransaction(t, func(tx *sql.Tx) {
 
-	if err != nil {
-		assert.NoError(t, err, "Failed to create JSON")
-	}
+		getService(t, tx, "Okko")
+		userUUID := faker.UUIDHyphenated()
 
-	req, err := http.NewRequest(httpMethod, url, bytes.NewBuffer(jsonData))
-	assert.NoError(t, err, "Failed to create request")
-	w := httptest.NewRecorder()
-	ginEngine.ServeHTTP(w, req)
-	assert.Equal(t, code, w.Code, "Expected status code %d, got %d", code, w.Code)
-	gjsonBody := gjson.Parse(w.Body.String())
+		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusCreated, map[string]interface{}{
+			"user_id":      userUUID,
+			"service_name": "Okko",
+			"price":        100,
+			"start_date":   "07-2025",
+		})
+		assert.Equal(t, userUUID, gjsonBody.Get("data.subscription.user_id").String())
 
-	return gjsonBody
+		// User is provisioned without credentials
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/users/"+userUUID, http.StatusOK, nil)
+		assert.Nil(t, gjsonBody.Get("data.user.login").Value())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/users/"+userUUID+"/subscriptions", http.StatusOK, nil)
+		assert.Len(t, gjsonBody.Get("data.subscriptions").Array(), 1)
+
+		// Subscription can be moved to another unknown user
+		otherUUID := faker.UUIDHyphenated()
+		url := "/subscriptions/" + gjsonBody.Get("data.subscriptions.0.id").String()
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPatch, url, http.StatusOK, map[string]interface{}{
+			"user_id": otherUUID,
+		})
+		assert.Equal(t, otherUUID, gjsonBody.Get("data.subscription.user_id").String())
+
+		sendAndTestRequest(t, http.MethodGet, "/users/"+otherUUID, http.StatusOK, nil)
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusBadRequest, map[string]interface{}{
+			"user_id":      "user-" + strings.Repeat("0", 31),
+			"service_name": "Okko",
+			"price":        100,
+			"start_date":   "07-2025",
+		})
+		assert.Equal(t, "uuid", gjsonBody.Get("error.details.#(field==\"user_id\").rule").String())
+	})
 }
 
-func assertResponseStructure(t *testing.T, json gjson.Result) {
+func TestSubscriptionOverlap(t *testing.T) {
 
-	assert.True(t, json.Get("data").Exists(), "Response does not contain 'data' key")
-	assert.True(t, json.Get("meta").Exists(), "Response does not contain 'meta' key")
-	assert.True(t, json.Get("error").Exists(), "Response does not contain 'error' key")
+	withTransaction(t, func(tx *sql.Tx) {
 
-	timestamp := json.Get("meta.timestamp")
-	assert.True(t, timestamp.Exists(), "Response does not contain 'timestamp' in 'meta'")
-	assert.NotEmpty(t, timestamp.String(), "Timestamp should not be empty")
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
 
-	assert.Nil(t, json.Get("error").Value(), "Error should be empty")
+		getService(t, tx, "Okko")
+		getService(t, tx, "Ivi")
+
+		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusCreated, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Okko",
+			"price":        100,
+			"start_date":   "07-2025",
+			"end_date":     "12-2025",
+		})
+		firstID := gjsonBody.Get("data.subscription.id").String()
+
+		// The end month is still active
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusConflict, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Okko",
+			"price":        100,
+			"start_date":   "12-2025",
+		})
+		assertErrorResponseStructure(t, gjsonBody, "conflict")
+		assert.Contains(t, gjsonBody.Get("error.message").String(), "subscription "+firstID+" ")
+
+		// Adjacent periods, other services and other users do not clash
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusCreated, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Okko",
+			"price":        100,
+			"start_date":   "01-2026",
+		})
+		secondID := gjsonBody.Get("data.subscription.id").String()
+
+		sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusCreated, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Ivi",
+			"price":        100,
+			"start_date":   "07-2025",
+		})
+
+		otherUser, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
+
+		sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusCreated, map[string]interface{}{
+			"user_id":      otherUser.UUID,
+			"service_name": "Okko",
+			"price":        100,
+			"start_date":   "07-2025",
+		})
+
+		// Ongoing subscription can not be moved back into the period of the first one
+		gjsonBody = sendAndTestRequest(t, http.MethodPatch, "/subscriptions/"+secondID, http.StatusConflict, map[string]interface{}{
+			"start_date": "11-2025",
+		})
+		assertErrorResponseStructure(t, gjsonBody, "conflict")
+		assert.Contains(t, gjsonBody.Get("error.message").String(), "subscription "+firstID+" ")
+
+		// Subscription does not clash with itself
+		sendAndTestRequest(t, http.MethodPatch, "/subscriptions/"+secondID, http.StatusOK, map[string]interface{}{
+			"start_date": "02-2026",
+		})
+	})
+}
+
+func TestImportSubscriptions(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
+
+		yandex := getService(t, tx, "Yandex")
+		okko := getService(t, tx, "Okko")
+		unknownUUID := faker.UUIDHyphenated()
+
+		_, err = factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithUser(user),
+			factory.SubscriptionWithService(okko),
+			withPrice(39900),
+			factory.SubscriptionStartDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			factory.SubscriptionEndDate(null.TimeFrom(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))),
+		)
+		assert.NoError(t, err, "Failed to create subscription")
+
+		rows := []interface{}{
+			map[string]interface{}{"user_id": user.UUID, "service_id": yandex.ID, "price": 19900, "start_date": "01-2025", "end_date": "03-2025"},
+			map[string]interface{}{"user_id": user.UUID, "service_name": "Yandex", "price": 19900, "start_date": "03-2025"},
+			map[string]interface{}{"user_id": user.UUID, "service_id": okko.ID, "price": 39900, "start_date": "05-2025"},
+			map[string]interface{}{"user_id": unknownUUID, "service_id": yandex.ID, "price": 100, "start_date": "01-2025"},
+			map[string]interface{}{"user_id": user.UUID, "service_id": "Okko", "price": -1, "start_date": "2025-01"},
+			map[string]interface{}{"user_id": user.UUID, "service_id": okko.ID, "price": 999, "currency": "USD", "start_date": "07-2025"},
+		}
+
+		// Nothing is imported when any row is invalid
+		w := sendRequest(t, http.MethodPost, "/subscriptions/import", rows, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		gjsonBody := gjson.Parse(w.Body.String())
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+		assert.Equal(t, `["rows[1].start_date","rows[2].start_date","rows[4].service_id"]`, gjsonBody.Get("error.details.#.field").Raw)
+		assert.Equal(t, `["overlap","overlap","type"]`, gjsonBody.Get("error.details.#.rule").Raw)
+
+		count, err := models.Subscriptions(models.SubscriptionWhere.UserID.EQ(user.UUID)).Count(ctx, tx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		// Unknown user is not provisioned when nothing is imported
+		exists, err := models.Users(models.UserWhere.UUID.EQ(unknownUUID)).Exists(ctx, tx)
+		assert.NoError(t, err)
+		assert.False(t, exists)
+
+		// Valid rows are imported in best effort mode
+		w = sendRequest(t, http.MethodPost, "/subscriptions/import?mode=best_effort", rows, nil)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		gjsonBody = gjson.Parse(w.Body.String())
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, int64(3), gjsonBody.Get("data.imported").Int())
+		assert.Equal(t, int64(3), gjsonBody.Get("data.failed").Int())
+		assert.Equal(t, "[0,3,5]", gjsonBody.Get("data.subscriptions.#.row").Raw)
+		assert.Len(t, gjsonBody.Get("data.errors").Array(), 3)
+
+		provisioned, err := models.Users(models.UserWhere.UUID.EQ(unknownUUID)).One(ctx, tx)
+		assert.NoError(t, err, "Unknown user is not provisioned")
+		assert.False(t, provisioned.Login.Valid)
+
+		subscription, err := models.Subscriptions(
+			models.SubscriptionWhere.UUID.EQ(gjsonBody.Get("data.subscriptions.2.id").String()),
+			qm.Load(models.SubscriptionRels.SubscriptionPrices),
+		).One(ctx, tx)
+		assert.NoError(t, err, "Imported subscription is not found")
+		assert.Equal(t, okko.ID, subscription.ServiceID)
+		assert.Equal(t, int64(999), subscription.R.SubscriptionPrices[0].Price)
+		assert.Equal(t, "USD", subscription.R.SubscriptionPrices[0].Currency)
+
+		entry, err := models.SubscriptionAudits(models.SubscriptionAuditWhere.SubscriptionUUID.EQ(subscription.UUID)).One(ctx, tx)
+		assert.NoError(t, err, "Import is not audited")
+		assert.Equal(t, audit.OperationInsert, entry.Operation)
+		assert.Equal(t, authUser.UUID, entry.Actor.String)
+
+		// CSV columns are matched by the header
+		body := "start_date,price,user_id,service_name\n" +
+			"08-2025,500," + user.UUID + ",Yandex\n" +
+			"09-2025,abc," + user.UUID + ",Yandex\n"
+
+		req, err := http.NewRequest(http.MethodPost, "/subscriptions/import?mode=best_effort", strings.NewReader(body))
+		assert.NoError(t, err, "Failed to create request")
+		req.Header.Set("Content-Type", "text/csv")
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		w = httptest.NewRecorder()
+		ginEngine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		gjsonBody = gjson.Parse(w.Body.String())
+		assert.Equal(t, int64(1), gjsonBody.Get("data.imported").Int())
+		assert.Equal(t, "rows[1].price", gjsonBody.Get("data.errors.0.field").String())
+
+		req, err = http.NewRequest(http.MethodPost, "/subscriptions/import", strings.NewReader("user_id,login\n"))
+		assert.NoError(t, err, "Failed to create request")
+		req.Header.Set("Content-Type", "text/csv")
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		w = httptest.NewRecorder()
+		ginEngine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "invalid_request")
+
+		// Reading stops at the first row past the limit
+		body = "start_date,price,user_id,service_name\n" +
+			strings.Repeat("10-2025,500,"+user.UUID+",Yandex\n", 10001)
+
+		req, err = http.NewRequest(http.MethodPost, "/subscriptions/import", strings.NewReader(body))
+		assert.NoError(t, err, "Failed to create request")
+		req.Header.Set("Content-Type", "text/csv")
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		w = httptest.NewRecorder()
+		ginEngine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		gjsonBody = gjson.Parse(w.Body.String())
+		assertErrorResponseStructure(t, gjsonBody, "invalid_request")
+		assert.Equal(t, "import is limited to 10000 rows", gjsonBody.Get("error.message").String())
+
+		// Body is not read past the size limit
+		body = "start_date,price,user_id,service_name\n" +
+			"10-2025,500," + user.UUID + "," + strings.Repeat("Y", 9<<20) + "\n"
+
+		req, err = http.NewRequest(http.MethodPost, "/subscriptions/import", strings.NewReader(body))
+		assert.NoError(t, err, "Failed to create request")
+		req.Header.Set("Content-Type", "text/csv")
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		w = httptest.NewRecorder()
+		ginEngine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "payload_too_large")
+	})
+}
+
+func TestReadSubscription(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+
+		assert.NoError(t, err, "Failed to create user")
+
+		subscription, err := factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionUserID(user.UUID),
+			factory.SubscriptionWithService(getService(t, tx, "Ivi")),
+			withPrice(100),
+		)
+
+		assert.NoError(t, err, "Failed to create subscription")
+
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, "/subscriptions/"+subscription.UUID, http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+
+		gjsonSubscription := gjsonBody.Get("data.subscription")
+		assert.True(t, gjsonSubscription.Exists())
+		assert.True(t, gjsonSubscription.IsObject())
+		assert.Len(t, gjsonSubscription.Map(), 10)
+		assert.Equal(t, subscription.UUID, gjsonSubscription.Get("id").String())
+		assert.Equal(t, user.UUID, gjsonSubscription.Get("user_id").String())
+		assert.Equal(t, "Ivi", gjsonSubscription.Get("service_name").Value())
+		assert.Equal(t, int64(100), gjsonSubscription.Get("price").Int())
+		assert.IsType(t, "", gjsonSubscription.Get("start_date").Value())
+	})
+}
+
+func TestUpdateSubscription(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+
+		assert.NoError(t, err, "Failed to create user")
+
+		subscription, err := factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionUserID(user.UUID),
+			factory.SubscriptionWithService(getService(t, tx, "Ivi")),
+			withPrice(100),
+		)
+
+		assert.NoError(t, err, "Failed to create subscription")
+
+		getService(t, tx, "Okko")
+
+		gjsonBody := sendAndTestRequest(t, http.MethodPut, "/subscriptions/"+subscription.UUID, http.StatusOK, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Okko",
+			"price":        200,
+			"start_date":   "07-2025",
+			"end_date":     "12-2025",
+		})
+
+		assertResponseStructure(t, gjsonBody)
+		gjsonSubscription := gjsonBody.Get("data.subscription")
+		assert.Equal(t, user.UUID, gjsonSubscription.Get("user_id").String())
+		assert.Equal(t, "Okko", gjsonSubscription.Get("service_name").String())
+		assert.Equal(t, int64(200), gjsonSubscription.Get("price").Int())
+
+		err = subscription.Reload(ctx, tx)
+		assert.NoError(t, err, "Failed to reload subscription")
+		assert.Equal(t, "07-2025", subscription.StartDate.Format("01-2006"))
+		assert.Equal(t, "12-2025", subscription.EndDate.Time.Format("01-2006"))
+
+		// PUT replaces the whole record, so required fields must be present
+		sendAndTestRequest(t, http.MethodPut, "/subscriptions/"+subscription.UUID, http.StatusBadRequest, map[string]interface{}{
+			"price": 300,
+		})
+	})
+}
+
+func TestPatchSubscription(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+
+		assert.NoError(t, err, "Failed to create user")
+
+		subscription, err := factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionUserID(user.UUID),
+			factory.SubscriptionWithService(getService(t, tx, "Ivi")),
+			withPrice(100),
+			factory.SubscriptionStartDate(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)),
+			factory.SubscriptionEndDate(null.TimeFrom(time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC))),
+		)
+
+		assert.NoError(t, err, "Failed to create subscription")
+
+		gjsonBody := sendAndTestRequest(t, http.MethodPatch, "/subscriptions/"+subscription.UUID, http.StatusOK, map[string]interface{}{
+			"price":    150,
+			"end_date": nil,
+		})
+
+		assertResponseStructure(t, gjsonBody)
+		gjsonSubscription := gjsonBody.Get("data.subscription")
+		assert.Equal(t, user.UUID, gjsonSubscription.Get("user_id").String())
+		assert.Equal(t, "Ivi", gjsonSubscription.Get("service_name").String())
+		assert.Equal(t, int64(150), gjsonSubscription.Get("price").Int())
+
+		err = subscription.Reload(ctx, tx)
+		assert.NoError(t, err, "Failed to reload subscription")
+		assert.Equal(t, "07-2025", subscription.StartDate.Format("01-2006"))
+		assert.False(t, subscription.EndDate.Valid)
+
+		// Service can be switched by name
+		getService(t, tx, "Okko")
+		gjsonBody = sendAndTestRequest(t, http.MethodPatch, "/subscriptions/"+subscription.UUID, http.StatusOK, map[string]interface{}{
+			"service_name": "Okko",
+		})
+		assert.Equal(t, "Okko", gjsonBody.Get("data.subscription.service_name").String())
+		assert.Equal(t, int64(150), gjsonBody.Get("data.subscription.price").Int())
+
+		// Required field can not be removed by merge patch
+		sendAndTestRequest(t, http.MethodPatch, "/subscriptions/"+subscription.UUID, http.StatusBadRequest, map[string]interface{}{
+			"service_name": nil,
+		})
+
+		sendAndTestRequest(t, http.MethodPatch, "/subscriptions/"+subscription.UUID, http.StatusBadRequest, map[string]interface{}{
+			"start_date": "2025-07",
+		})
+
+		// Merge patch semantics apply to the merge patch documents only
+		w := sendRequest(t, http.MethodPatch, "/subscriptions/"+subscription.UUID, map[string]interface{}{
+			"price": 200,
+		}, map[string]string{"Content-Type": binding.MIMEJSON})
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+		assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "unsupported_media_type")
+	})
+}
+
+func TestSubscriptionPrices(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
+
+		getService(t, tx, "Okko")
+
+		gjsonBody := sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusCreated, map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Okko",
+			"price":        10,
+			"start_date":   "01-2025",
+		})
+		url := "/subscriptions/" + gjsonBody.Get("data.subscription.id").String()
+
+		// Price rise in March does not rewrite January and February
+		gjsonBody = sendAndTestRequest(t, http.MethodPatch, url, http.StatusOK, map[string]interface{}{
+			"price":                20,
+			"price_effective_from": "03-2025",
+		})
+		assert.Equal(t, int64(20), gjsonBody.Get("data.subscription.price").Int())
+
+		// Scheduled change does not affect the current price
+		gjsonBody = sendAndTestRequest(t, http.MethodPatch, url, http.StatusOK, map[string]interface{}{
+			"price":                30,
+			"price_effective_from": "01-2099",
+		})
+		assert.Equal(t, int64(20), gjsonBody.Get("data.subscription.price").Int())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, map[string]interface{}{
+			"user_id":   user.UUID,
+			"from_date": "01-01-2025",
+			"to_date":   "30-04-2025",
+		})
+		assert.Equal(t, int64(4), gjsonBody.Get("data.subscriptions.0.months").Int())
+		assert.Equal(t, int64(60), gjsonBody.Get("data.sum").Int())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, url+"/prices", http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+		gjsonPrices := gjsonBody.Get("data.prices")
+		assert.Len(t, gjsonPrices.Array(), 3)
+		assert.Equal(t, "01-2025", gjsonPrices.Get("0.effective_from").String())
+		assert.Equal(t, int64(10), gjsonPrices.Get("0.price").Int())
+		assert.True(t, gjsonPrices.Get("1.current").Bool())
+		assert.True(t, gjsonPrices.Get("2.scheduled").Bool())
+		assert.False(t, gjsonPrices.Get("2.current").Bool())
+
+		// Price change can not precede the subscription
+		sendAndTestRequest(t, http.MethodPatch, url, http.StatusBadRequest, map[string]interface{}{
+			"price":                40,
+			"price_effective_from": "12-2024",
+		})
+
+		sendAndTestRequest(t, http.MethodGet, "/subscriptions/"+strconv.Itoa(math.MaxInt32)+"/prices", http.StatusNotFound, nil)
+	})
+}
+
+func TestUpdateMissingSubscription(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		httpMethod := []string{http.MethodPatch, http.MethodPut}[rand.Intn(2)]
+		sendAndTestRequest(t, httpMethod, "/subscriptions/"+strconv.Itoa(math.MaxInt32), http.StatusNotFound, map[string]interface{}{
+			"price": 100,
+		})
+	})
+}
+
+func TestDeleteSubscription(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+
+		assert.NoError(t, err, "Failed to create user")
+
+		subscription, err := factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionUserID(user.UUID),
+			factory.SubscriptionWithService(getService(t, tx, "Ivi")),
+			withPrice(100),
+		)
+
+		assert.NoError(t, err, "Failed to create subscription")
+
+		sendAndTestRequest(t, http.MethodDelete, "/subscriptions/"+subscription.UUID, http.StatusNoContent, nil)
+	})
+}
+
+func TestRestoreSubscription(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
+
+		ivi := getService(t, tx, "Ivi")
+
+		subscription, err := factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithUser(user),
+			factory.SubscriptionWithService(ivi),
+			withPrice(100),
+			factory.SubscriptionStartDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			factory.SubscriptionEndDate(null.TimeFrom(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))),
+		)
+		assert.NoError(t, err, "Failed to create subscription")
+
+		url := "/subscriptions/" + subscription.UUID
+
+		sendAndTestRequest(t, http.MethodDelete, url, http.StatusNoContent, nil)
+		sendAndTestRequest(t, http.MethodDelete, url, http.StatusNotFound, nil)
+		sendAndTestRequest(t, http.MethodGet, url, http.StatusNotFound, nil)
+
+		report := map[string]interface{}{"user_id": user.UUID, "from_date": "01-01-2025", "to_date": "31-12-2025"}
+
+		// Deleted subscriptions are shown to the admins only
+		for _, route := range [][2]string{
+			{http.MethodGet, url},
+			{http.MethodGet, "/subscriptions"},
+			{http.MethodGet, "/subscriptions/export"},
+			{http.MethodGet, "/users/" + user.UUID + "/subscriptions"},
+			{http.MethodPost, "/subscriptions/report"},
+		} {
+			gjsonBody := sendAndTestRequest(t, route[0], route[1]+"?with_deleted=true", http.StatusForbidden, report)
+			assertErrorResponseStructure(t, gjsonBody, "forbidden")
+		}
+
+		authUser.IsAdmin = true
+		_, err = authUser.Update(ctx, tx, boil.Whitelist(models.UserColumns.IsAdmin))
+		assert.NoError(t, err, "Failed to make user admin")
+
+		// Deleted subscription is still stored and shown on request
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, url+"?with_deleted=true", http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+		assert.NotEmpty(t, gjsonBody.Get("data.subscription.deleted_at").String())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report", http.StatusOK, report)
+		assert.Equal(t, int64(0), gjsonBody.Get("data.count").Int())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions/report?with_deleted=true", http.StatusOK, report)
+		assert.Equal(t, int64(1), gjsonBody.Get("data.count").Int())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, url+"/restore", http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+		assert.Nil(t, gjsonBody.Get("data.subscription.deleted_at").Value())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, url+"/restore", http.StatusConflict, nil)
+		assertErrorResponseStructure(t, gjsonBody, "conflict")
+
+		sendAndTestRequest(t, http.MethodPost, "/subscriptions/999999999/restore", http.StatusNotFound, nil)
+
+		// Period of the deleted subscription can be taken by a new one, which then blocks the restore
+		sendAndTestRequest(t, http.MethodDelete, url, http.StatusNoContent, nil)
+
+		_, err = factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithUser(user),
+			factory.SubscriptionWithService(ivi),
+			withPrice(100),
+			factory.SubscriptionStartDate(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+		)
+		assert.NoError(t, err, "Failed to create subscription overlapping the deleted one")
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, url+"/restore", http.StatusConflict, nil)
+		assertErrorResponseStructure(t, gjsonBody, "conflict")
+		assert.Contains(t, gjsonBody.Get("error.message").String(), "overlaps")
+	})
+}
+
+func TestSubscriptionHistory(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
+
+		getService(t, tx, "Okko")
+
+		w := sendRequest(t, http.MethodPost, "/subscriptions", map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Okko",
+			"price":        100,
+			"start_date":   "07-2025",
+		}, map[string]string{"X-Request-ID": "history-create"})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		url := "/subscriptions/" + gjson.Get(w.Body.String(), "data.subscription.id").String()
+
+		sendAndTestRequest(t, http.MethodPatch, url, http.StatusOK, map[string]interface{}{
+			"price":                200,
+			"price_effective_from": "09-2025",
+			"end_date":             "12-2025",
+		})
+
+		// Update changing nothing is not recorded
+		sendAndTestRequest(t, http.MethodPatch, url, http.StatusOK, map[string]interface{}{
+			"price":                200,
+			"price_effective_from": "09-2025",
+		})
+
+		sendAndTestRequest(t, http.MethodDelete, url, http.StatusNoContent, nil)
+		sendAndTestRequest(t, http.MethodPost, url+"/restore", http.StatusOK, nil)
+
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, url+"/history?limit=2", http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+
+		history := gjsonBody.Get("data.history").Array()
+		assert.Len(t, history, 2)
+
+		assert.Equal(t, "insert", history[0].Get("operation").String())
+		assert.Equal(t, "history-create", history[0].Get("request_id").String())
+		assert.Equal(t, authUser.UUID, history[0].Get("actor").String())
+		assert.Nil(t, history[0].Get("changes.start_date.before").Value())
+		assert.Equal(t, "07-2025", history[0].Get("changes.start_date.after").String())
+		assert.Equal(t, user.UUID, history[0].Get("changes.user_id.after").String())
+
+		assert.Equal(t, "update", history[1].Get("operation").String())
+		assert.NotEmpty(t, history[1].Get("request_id").String())
+		assert.NotEmpty(t, history[1].Get("created_at").String())
+
+		changes := history[1].Get("changes").Map()
+		assert.Len(t, changes, 2)
+		assert.Nil(t, changes["end_date"].Get("before").Value())
+		assert.Equal(t, "12-2025", changes["end_date"].Get("after").String())
+		assert.Nil(t, changes["prices[09-2025]"].Get("before").Value())
+		assert.Equal(t, int64(200), changes["prices[09-2025]"].Get("after.price").Int())
+		assert.Equal(t, "RUB", changes["prices[09-2025]"].Get("after.currency").String())
+
+		nextCursor := gjsonBody.Get("meta.pagination.next_cursor").String()
+		assert.NotEmpty(t, nextCursor)
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, url+"/history?limit=2&cursor="+nextCursor, http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+		assert.Nil(t, gjsonBody.Get("meta.pagination.next_cursor").Value())
+
+		history = gjsonBody.Get("data.history").Array()
+		assert.Len(t, history, 2)
+
+		assert.Equal(t, "delete", history[0].Get("operation").String())
+		assert.Nil(t, history[0].Get("changes.deleted_at.before").Value())
+		assert.NotEmpty(t, history[0].Get("changes.deleted_at.after").String())
+
+		assert.Equal(t, "restore", history[1].Get("operation").String())
+		assert.Nil(t, history[1].Get("changes.deleted_at.after").Value())
+
+		// History of the deleted subscription is still available
+		sendAndTestRequest(t, http.MethodDelete, url, http.StatusNoContent, nil)
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, url+"/history", http.StatusOK, nil)
+		assert.Len(t, gjsonBody.Get("data.history").Array(), 5)
+
+		// Every change is attributed to the user who made it
+		for _, entry := range gjsonBody.Get("data.history").Array() {
+			assert.Equal(t, authUser.UUID, entry.Get("actor").String(), "Actor of %s is not recorded", entry.Get("operation"))
+		}
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/subscriptions/999999999/history", http.StatusNotFound, nil)
+		assertErrorResponseStructure(t, gjsonBody, "not_found")
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, url+"/history?cursor=bad", http.StatusBadRequest, nil)
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+	})
+}
+
+func TestIdempotencyKey(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
+
+		getService(t, tx, "Okko")
+
+		data := map[string]interface{}{
+			"user_id":      user.UUID,
+			"service_name": "Okko",
+			"price":        100,
+			"start_date":   "07-2025",
+		}
+		headers := map[string]string{"Idempotency-Key": faker.UUIDHyphenated()}
+
+		w := sendRequest(t, http.MethodPost, "/subscriptions", data, headers)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+
+		// Repeated request gets the stored response instead of the overlap conflict
+		replay := sendRequest(t, http.MethodPost, "/subscriptions", data, headers)
+		assert.Equal(t, http.StatusCreated, replay.Code)
+		assert.Equal(t, "true", replay.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, w.Header().Get("Location"), replay.Header().Get("Location"))
+		assert.JSONEq(t, w.Body.String(), replay.Body.String())
+
+		count, err := models.Subscriptions(models.SubscriptionWhere.UserID.EQ(user.UUID)).Count(ctx, tx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		data["price"] = 200
+
+		w = sendRequest(t, http.MethodPost, "/subscriptions", data, headers)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "unprocessable_entity")
+
+		url := replay.Header().Get("Location")
+		headers = map[string]string{"Idempotency-Key": faker.UUIDHyphenated()}
+
+		w = sendRequest(t, http.MethodDelete, url, nil, headers)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		w = sendRequest(t, http.MethodDelete, url, nil, headers)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+
+		// The same key can not be used for another endpoint
+		w = sendRequest(t, http.MethodPost, url+"/restore", nil, headers)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		// Every user has own keys: the same key of another user is neither replayed nor reported as used
+		_, otherToken := createAuthenticatedUser(t, tx, faker.Password())
+		headers["Authorization"] = "Bearer " + otherToken
+
+		w = sendRequest(t, http.MethodPost, url+"/restore", nil, headers)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+
+		stored, err := models.IdempotencyKeys(models.IdempotencyKeyWhere.Key.EQ(headers["Idempotency-Key"])).Count(ctx, tx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), stored)
+
+		w = sendRequest(t, http.MethodPost, "/subscriptions", data, map[string]string{"Idempotency-Key": strings.Repeat("k", 256)})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "invalid_request")
+
+		// Body hashed for the key is not read past the limit either
+		req, err := http.NewRequest(http.MethodPost, "/subscriptions/import", strings.NewReader(strings.Repeat(" ", request.MaxBodySize+1)))
+		assert.NoError(t, err, "Failed to create request")
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		req.Header.Set("Idempotency-Key", faker.UUIDHyphenated())
+		w = httptest.NewRecorder()
+		ginEngine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "payload_too_large")
+	})
+}
+
+func TestSubscriptionETag(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
+
+		subscription, err := factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithUser(user),
+			factory.SubscriptionWithService(getService(t, tx, "Okko")),
+			withPrice(100),
+			factory.SubscriptionStartDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		)
+		assert.NoError(t, err, "Failed to create subscription")
+
+		url := "/subscriptions/" + subscription.UUID
+
+		w := sendRequest(t, http.MethodGet, url, nil, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+
+		for _, etag := range []string{`"1"`, `W/"1"`, `"5", "1"`, "*"} {
+			w = sendRequest(t, http.MethodGet, url, nil, map[string]string{"If-None-Match": etag})
+			assert.Equal(t, http.StatusNotModified, w.Code, "If-None-Match: %s", etag)
+			assert.Empty(t, w.Body.String())
+			assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+		}
+
+		w = sendRequest(t, http.MethodGet, url, nil, map[string]string{"If-None-Match": `"2"`})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		patch := map[string]interface{}{"end_date": "12-2025"}
+
+		w = sendRequest(t, http.MethodPatch, url, patch, map[string]string{"If-Match": `"2"`})
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+		assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "precondition_failed")
+
+		// Weak tags never match If-Match
+		w = sendRequest(t, http.MethodPatch, url, patch, map[string]string{"If-Match": `W/"1"`})
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+		w = sendRequest(t, http.MethodPatch, url, patch, map[string]string{"If-Match": `"1"`})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+
+		// If-Match is not required by default
+		w = sendRequest(t, http.MethodPut, url, map[string]interface{}{
+			"user_id":    user.UUID,
+			"service_id": subscription.ServiceID,
+			"price":      200,
+			"start_date": "01-2025",
+		}, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+
+		w = sendRequest(t, http.MethodDelete, url, nil, map[string]string{"If-Match": `"2"`})
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+		w = sendRequest(t, http.MethodDelete, url, nil, map[string]string{"If-Match": `"3"`})
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		w = sendRequest(t, http.MethodPost, url+"/restore", nil, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"5"`, w.Header().Get("ETag"))
+
+		// Engine requiring If-Match
+		cfg, err := config.Load("../../..")
+		assert.NoError(t, err)
+		cfg.Subscriptions.IfMatchRequired = true
+		engine := bootstrap.SetUpGin(gin.TestMode, cfg.Idempotency, cfg.Subscriptions, issuer, slog.Default())
+
+		for _, method := range []string{http.MethodPut, http.MethodPatch, http.MethodDelete} {
+			req, err := http.NewRequest(method, url, strings.NewReader(`{"end_date": "12-2025"}`))
+			assert.NoError(t, err, "Failed to create request")
+			req.Header.Set("Content-Type", getContentType(method))
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+
+			w = httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusPreconditionRequired, w.Code, method)
+			assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "precondition_required")
+		}
+	})
+}
+
+func TestSubscriptionIntegerID(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		subscription, err := factory.CreateAndInsertSubscription(ctx, tx,
+			factory.SubscriptionWithNewUser(nil,
+				factory.UserLogin(null.StringFrom(faker.Username())),
+				factory.UserPasswordHash(null.StringFrom(faker.Password())),
+			),
+			factory.SubscriptionWithService(getService(t, tx, "Kion")),
+			withPrice(100),
+		)
+		assert.NoError(t, err, "Failed to create subscription")
+
+		w := sendRequest(t, http.MethodGet, "/subscriptions/"+subscription.UUID, nil, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Deprecation"))
+
+		// Integer IDs are accepted during the transition period by default
+		url := "/subscriptions/" + strconv.Itoa(subscription.ID)
+
+		w = sendRequest(t, http.MethodGet, url, nil, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "true", w.Header().Get("Deprecation"))
+		assert.Equal(t, subscription.UUID, gjson.Get(w.Body.String(), "data.subscription.id").String())
+
+		w = sendRequest(t, http.MethodGet, url+"/history", nil, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "true", w.Header().Get("Deprecation"))
+
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, "/subscriptions/not-a-uuid", http.StatusBadRequest, nil)
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+
+		// Engine accepting UUIDs only
+		cfg, err := config.Load("../../..")
+		assert.NoError(t, err)
+		cfg.Subscriptions.IntegerIDs = false
+		engine := bootstrap.SetUpGin(gin.TestMode, cfg.Idempotency, cfg.Subscriptions, issuer, slog.Default())
+
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		assert.NoError(t, err, "Failed to create request")
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+
+		w = httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assertErrorResponseStructure(t, gjson.Parse(w.Body.String()), "validation_failed")
+	})
+}
+
+func TestSubscriptionErrors(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		missingURL := "/subscriptions/" + strconv.Itoa(math.MaxInt32)
+
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, missingURL, http.StatusNotFound, nil)
+		assertErrorResponseStructure(t, gjsonBody, "not_found")
+
+		gjsonBody = sendAndTestRequest(t, http.MethodDelete, missingURL, http.StatusNotFound, nil)
+		assertErrorResponseStructure(t, gjsonBody, "not_found")
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusBadRequest, map[string]interface{}{
+			"service_name": "Okko",
+			"price":        -1,
+		})
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+		assert.Equal(t, "gt", gjsonBody.Get("error.details.#(field==\"price\").rule").String())
+		assert.Equal(t, "required", gjsonBody.Get("error.details.#(field==\"user_id\").rule").String())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodPost, "/subscriptions", http.StatusNotFound, map[string]interface{}{
+			"user_id":      "00000000-0000-0000-0000-000000000000",
+			"service_name": "Okko",
+			"price":        100,
+			"start_date":   "07-2025",
+		})
+		assertErrorResponseStructure(t, gjsonBody, "not_found")
+
+		// Problem details are rendered on demand
+		w := sendRequest(t, http.MethodGet, missingURL, nil, map[string]string{"Accept": "application/problem+json"})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+		gjsonBody = gjson.Parse(w.Body.String())
+		assert.Equal(t, int64(http.StatusNotFound), gjsonBody.Get("status").Int())
+		assert.Equal(t, "not_found", gjsonBody.Get("code").String())
+		assert.NotEmpty(t, gjsonBody.Get("title").String())
+	})
+}
+
+func TestRequestID(t *testing.T) {
+
+	w := sendRequest(t, http.MethodGet, "/ping", nil, map[string]string{"X-Request-ID": "client-request-42"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "client-request-42", w.Header().Get("X-Request-ID"))
+
+	// Unsafe request ID is replaced with the generated one
+	w = sendRequest(t, http.MethodGet, "/ping", nil, map[string]string{"X-Request-ID": "bad id\n"})
+	assert.Len(t, w.Header().Get("X-Request-ID"), 32)
+
+	w = sendRequest(t, http.MethodGet, "/ping", nil, nil)
+	assert.Len(t, w.Header().Get("X-Request-ID"), 32)
+}
+
+// Subscription charged with the same price in rubles for its whole period
+func withPrice(price int64) factory.SubscriptionMod {
+
+	return factory.SubscriptionWithNewSubscriptionPrices(nil, 1, factory.SubscriptionPricePrice(price))
+}
+
+// Find service by name or create it
+func getService(t *testing.T, tx *sql.Tx, name string) *models.Service {
+
+	service, err := models.Services(models.ServiceWhere.Name.EQ(name)).One(ctx, tx)
+	if err == nil {
+		return service
+	}
+
+	service, err = factory.CreateAndInsertService(ctx, tx, factory.ServiceName(name))
+	assert.NoError(t, err, "Failed to create service %s", name)
+
+	return service
+}
+
+func sendAndTestRequest(t *testing.T, httpMethod, url string, code int, data map[string]interface{}) gjson.Result {
+
+	w := sendRequest(t, httpMethod, url, data, nil)
+	assert.Equal(t, code, w.Code, "Expected status code %d, got %d", code, w.Code)
+	gjsonBody := gjson.Parse(w.Body.String())
+
+	return gjsonBody
+}
+
+func sendRequest(t *testing.T, httpMethod, url string, data interface{}, headers map[string]string) *httptest.ResponseRecorder {
+
+	jsonData, err := json.Marshal(data)
+
+	if err != nil {
+		assert.NoError(t, err, "Failed to create JSON")
+	}
+
+	req, err := http.NewRequest(httpMethod, url, bytes.NewBuffer(jsonData))
+	assert.NoError(t, err, "Failed to create request")
+
+	req.Header.Set("Content-Type", getContentType(httpMethod))
+
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	w := httptest.NewRecorder()
+	ginEngine.ServeHTTP(w, req)
+
+	return w
+}
+
+// Body of PATCH request is a merge patch document, of the other requests is a plain JSON
+func getContentType(httpMethod string) string {
+
+	if httpMethod == http.MethodPatch {
+		return request.MergePatchContentType
+	}
+
+	return binding.MIMEJSON
+}
+
+func assertResponseStructure(t *testing.T, json gjson.Result) {
+
+	assert.True(t, json.Get("data").Exists(), "Response does not contain 'data' key")
+	assert.True(t, json.Get("meta").Exists(), "Response does not contain 'meta' key")
+	assert.True(t, json.Get("error").Exists(), "Response does not contain 'error' key")
+
+	timestamp := json.Get("meta.timestamp")
+	assert.True(t, timestamp.Exists(), "Response does not contain 'timestamp' in 'meta'")
+	assert.NotEmpty(t, timestamp.String(), "Timestamp should not be empty")
+
+	assert.Nil(t, json.Get("error").Value(), "Error should be empty")
+}
+
+func assertErrorResponseStructure(t *testing.T, json gjson.Result, code string) {
+
+	assert.True(t, json.Get("data").Exists(), "Response does not contain 'data' key")
+	assert.Nil(t, json.Get("data").Value(), "Data should be empty")
+	assert.True(t, json.Get("meta.timestamp").Exists(), "Response does not contain 'timestamp' in 'meta'")
+	assert.Equal(t, code, json.Get("error.code").String())
+	assert.NotEmpty(t, json.Get("error.message").String(), "Error message should not be empty")
 }
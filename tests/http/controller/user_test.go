@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/assert"
+	factory "github.com/zeleniy/test28/database/factories"
+)
+
+func TestGetUsers(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		for _, login := range []string{"petrov_search", "ivanov_search", "sidorov"} {
+			_, err := factory.CreateAndInsertUser(ctx, tx,
+				factory.UserLogin(null.StringFrom(login)),
+				factory.UserPasswordHash(null.StringFrom(faker.Password())),
+			)
+			assert.NoError(t, err, "Failed to create user")
+		}
+
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, "/users?login=_SEARCH&limit=1", http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+
+		gjsonUsers := gjsonBody.Get("data.users")
+		assert.True(t, gjsonUsers.IsArray())
+		assert.Len(t, gjsonUsers.Array(), 1)
+		assert.Equal(t, "ivanov_search", gjsonUsers.Get("0.login").String())
+		assert.Len(t, gjsonUsers.Get("0").Map(), 3)
+		assert.Equal(t, int64(1), gjsonBody.Get("meta.pagination.next_offset").Int())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/users?limit=0", http.StatusBadRequest, nil)
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+	})
+}
+
+func TestReadUser(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
+
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, "/users/"+user.UUID, http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, user.UUID, gjsonBody.Get("data.user.id").String())
+		assert.Equal(t, user.Login.String, gjsonBody.Get("data.user.login").String())
+		assert.False(t, gjsonBody.Get("data.user.password_hash").Exists())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/users/"+faker.UUIDHyphenated(), http.StatusNotFound, nil)
+		assertErrorResponseStructure(t, gjsonBody, "not_found")
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/users/1", http.StatusBadRequest, nil)
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+	})
+}
+
+func TestGetUserSubscriptions(t *testing.T) {
+
+	withTransaction(t, func(tx *sql.Tx) {
+
+		user, err := factory.CreateAndInsertUser(ctx, tx,
+			factory.UserLogin(null.StringFrom(faker.Username())),
+			factory.UserPasswordHash(null.StringFrom(faker.Password())),
+		)
+		assert.NoError(t, err, "Failed to create user")
+
+		now := time.Now().UTC()
+		currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		// Ended, active and scheduled in the current month
+		periods := []struct {
+			startDate time.Time
+			endDate   null.Time
+		}{
+			{currentMonth.AddDate(0, -6, 0), null.TimeFrom(currentMonth.AddDate(0, -3, 0))},
+			{currentMonth.AddDate(0, -2, 0), null.Time{}},
+			{currentMonth.AddDate(0, 2, 0), null.Time{}},
+		}
+
+		for i, period := range periods {
+			_, err = factory.CreateAndInsertSubscription(ctx, tx,
+				factory.SubscriptionWithUser(user),
+				factory.SubscriptionWithService(getService(t, tx, "Service "+strconv.Itoa(i))),
+				withPrice(100),
+				factory.SubscriptionStartDate(period.startDate),
+				factory.SubscriptionEndDate(period.endDate),
+			)
+			assert.NoError(t, err, "Failed to create subscription")
+		}
+
+		gjsonBody := sendAndTestRequest(t, http.MethodGet, "/users/"+user.UUID+"/subscriptions", http.StatusOK, nil)
+		assertResponseStructure(t, gjsonBody)
+		assert.Equal(t, user.UUID, gjsonBody.Get("data.user.id").String())
+		assert.Len(t, gjsonBody.Get("data.subscriptions").Array(), 3)
+		assert.Equal(t, user.UUID, gjsonBody.Get("data.subscriptions.0.user_id").String())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/users/"+user.UUID+"/subscriptions?status=active", http.StatusOK, nil)
+		assert.Len(t, gjsonBody.Get("data.subscriptions").Array(), 1)
+		assert.Equal(t, periods[1].startDate.Format("01-2006"), gjsonBody.Get("data.subscriptions.0.start_date").String())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/users/"+user.UUID+"/subscriptions?status=ended&status=scheduled&limit=1", http.StatusOK, nil)
+		assert.Len(t, gjsonBody.Get("data.subscriptions").Array(), 1)
+		assert.Equal(t, periods[0].startDate.Format("01-2006"), gjsonBody.Get("data.subscriptions.0.start_date").String())
+		assert.Equal(t, int64(1), gjsonBody.Get("meta.pagination.next_offset").Int())
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/users/"+user.UUID+"/subscriptions?status=paused", http.StatusBadRequest, nil)
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/users/"+faker.UUIDHyphenated()+"/subscriptions", http.StatusNotFound, nil)
+		assertErrorResponseStructure(t, gjsonBody, "not_found")
+
+		gjsonBody = sendAndTestRequest(t, http.MethodGet, "/users/not-a-uuid/subscriptions", http.StatusBadRequest, nil)
+		assertErrorResponseStructure(t, gjsonBody, "validation_failed")
+	})
+}